@@ -0,0 +1,142 @@
+package channel
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/Warashi/go-generics/clock"
+)
+
+// Batch groups the values received from in into slices of at most maxSize values.
+// A batch is sent once it holds maxSize values, or maxWait after its first value was received, whichever comes first.
+// maxSize less than 1 means no size limit, and maxWait less than or equal to 0 means no time limit.
+// The remaining values are sent as a last batch when in is closed. The returned channel is closed when in is closed or ctx is done.
+func Batch[T any](ctx context.Context, in <-chan T, maxSize int, maxWait time.Duration, opts ...Option) <-chan []T {
+	c := newConfig(opts)
+	result := make(chan []T)
+	go func() {
+		defer close(result)
+		var (
+			batch  []T
+			timer  clock.Timer
+			expire <-chan time.Time
+		)
+		stop := func() {
+			if timer != nil {
+				timer.Stop()
+				timer, expire = nil, nil
+			}
+		}
+		defer stop()
+		flush := func() bool {
+			stop()
+			b := batch
+			batch = nil
+			select {
+			case <-ctx.Done():
+				return false
+			case result <- b:
+				return true
+			}
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-expire:
+				if !flush() {
+					return
+				}
+			case v, ok := <-in:
+				if !ok {
+					if len(batch) > 0 {
+						flush()
+					}
+					return
+				}
+				batch = append(batch, v)
+				if len(batch) == 1 && maxWait > 0 {
+					timer = c.clock.NewTimer(maxWait)
+					expire = timer.C()
+				}
+				if maxSize > 0 && len(batch) >= maxSize {
+					if !flush() {
+						return
+					}
+				}
+			}
+		}
+	}()
+	return result
+}
+
+// WindowByTime sends, every slide, the values received from in during the last size.
+// size is rounded up to a multiple of slide; WindowByTime is a tumbling window when size equals slide,
+// and a sliding window in which each value appears in size/slide windows when size is larger.
+// Empty windows are skipped. When in is closed, the values received since the last window are sent
+// in a last window together with the preceding values that belong to it.
+// The returned channel is closed when in is closed or ctx is done. WindowByTime panics if size or slide is not positive.
+func WindowByTime[T any](ctx context.Context, in <-chan T, size, slide time.Duration, opts ...Option) <-chan []T {
+	if size <= 0 {
+		panic("non-positive size for channel.WindowByTime")
+	}
+	if slide <= 0 {
+		panic("non-positive slide for channel.WindowByTime")
+	}
+	c := newConfig(opts)
+	n := int((size + slide - 1) / slide)
+	result := make(chan []T)
+	go func() {
+		defer close(result)
+		ticker := c.clock.NewTicker(slide)
+		defer ticker.Stop()
+
+		// buckets holds the values received in each of the last n-1 slides; current holds the values of the ongoing one.
+		var (
+			buckets [][]T
+			current []T
+		)
+		send := func() bool {
+			window := slices.Concat(append(slices.Clip(buckets), current)...)
+			if len(window) == 0 {
+				return true
+			}
+			select {
+			case <-ctx.Done():
+				return false
+			case result <- window:
+				return true
+			}
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C():
+				if !send() {
+					return
+				}
+				buckets = append(buckets, current)
+				if len(buckets) > n-1 {
+					buckets = buckets[1:]
+				}
+				current = nil
+			case v, ok := <-in:
+				if !ok {
+					if len(current) > 0 {
+						send()
+					}
+					return
+				}
+				current = append(current, v)
+			}
+		}
+	}()
+	return result
+}
+
+// TumblingWindowByTime sends, every size, the values received from in since the previous window.
+func TumblingWindowByTime[T any](ctx context.Context, in <-chan T, size time.Duration, opts ...Option) <-chan []T {
+	return WindowByTime(ctx, in, size, size, opts...)
+}
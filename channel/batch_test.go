@@ -0,0 +1,148 @@
+package channel_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Warashi/go-generics/channel"
+	"github.com/Warashi/go-generics/clock"
+)
+
+var epoch = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestBatch(t *testing.T) {
+	t.Run("size", func(t *testing.T) {
+		noLeak(t)
+		got := collect(channel.Batch(context.Background(), of(1, 2, 3, 4, 5), 2, 0))
+		if want := [][]int{{1, 2}, {3, 4}, {5}}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+	t.Run("wait", func(t *testing.T) {
+		noLeak(t)
+		clk := clock.NewFake(epoch)
+		in := make(chan int)
+		ch := channel.Batch(context.Background(), in, 10, time.Second, channel.WithClock(clk))
+		in <- 1
+		in <- 2
+		clk.BlockUntil(1)
+		clk.Advance(time.Second)
+		if got, want := <-ch, []int{1, 2}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+		// the timer starts with the first value of each batch.
+		clk.Advance(time.Hour)
+		in <- 3
+		clk.BlockUntil(1)
+		clk.Advance(time.Second - time.Nanosecond)
+		in <- 4
+		close(in)
+		if got, want := collect(ch), [][]int{{3, 4}}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+	t.Run("context canceled", func(t *testing.T) {
+		noLeak(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := channel.Batch(ctx, endless(ctx), 2, time.Hour)
+		<-ch
+		cancel()
+		for range ch {
+		}
+	})
+}
+
+func TestWindowByTime(t *testing.T) {
+	t.Run("tumbling", func(t *testing.T) {
+		noLeak(t)
+		clk := clock.NewFake(epoch)
+		in := make(chan int)
+		ch := channel.TumblingWindowByTime(context.Background(), in, time.Second, channel.WithClock(clk))
+		clk.BlockUntil(1)
+		in <- 1
+		in <- 2
+		clk.Advance(time.Second)
+		if got, want := <-ch, []int{1, 2}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+		in <- 3
+		clk.Advance(time.Second)
+		if got, want := <-ch, []int{3}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+		in <- 4
+		close(in)
+		if got, want := collect(ch), [][]int{{4}}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+	t.Run("empty windows are skipped", func(t *testing.T) {
+		noLeak(t)
+		clk := clock.NewFake(epoch)
+		in := make(chan int)
+		ch := channel.TumblingWindowByTime(context.Background(), in, time.Second, channel.WithClock(clk))
+		clk.BlockUntil(1)
+		clk.Advance(time.Second)
+		close(in)
+		if got := collect(ch); len(got) != 0 {
+			t.Errorf("got %v, want no windows", got)
+		}
+	})
+	t.Run("sliding", func(t *testing.T) {
+		noLeak(t)
+		clk := clock.NewFake(epoch)
+		in := make(chan int)
+		ch := channel.WindowByTime(context.Background(), in, 2*time.Second, time.Second, channel.WithClock(clk))
+		clk.BlockUntil(1)
+		for _, want := range [][]int{{1}, {1, 2}, {2, 3}} {
+			in <- want[len(want)-1]
+			clk.Advance(time.Second)
+			if got := <-ch; !cmp.Equal(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		}
+		in <- 4
+		close(in)
+		if got, want := collect(ch), [][]int{{3, 4}}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+	t.Run("invalid durations", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			size, slide time.Duration
+		}{
+			{name: "zero slide", size: time.Second, slide: 0},
+			{name: "negative slide", size: time.Second, slide: -time.Second},
+			{name: "zero size", size: 0, slide: time.Second},
+			{name: "negative size", size: -time.Second, slide: time.Second},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("WindowByTime(%v, %v) did not panic", tt.size, tt.slide)
+					}
+				}()
+				channel.WindowByTime(context.Background(), of(1), tt.size, tt.slide)
+			})
+		}
+	})
+	t.Run("context canceled", func(t *testing.T) {
+		noLeak(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		clk := clock.NewFake(epoch)
+		in := make(chan int)
+		ch := channel.WindowByTime(ctx, in, time.Second, time.Second, channel.WithClock(clk))
+		clk.BlockUntil(1)
+		in <- 1
+		clk.Advance(time.Second)
+		<-ch
+		cancel()
+		for range ch {
+		}
+	})
+}
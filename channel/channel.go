@@ -17,3 +17,15 @@ func Filter[T any](from <-chan T, f types.Function[T, bool]) <-chan T {
 func ForEach[T any](from <-chan T, f types.Consumer[T]) {
 	monad.Do[types.Void, <-chan types.Void](MonadImpl[T, types.Void]{}, from, f)
 }
+func Reduce[T, A any](from <-chan T, init A, f types.BiFunction[A, T, A]) A {
+	return monad.Reduce[T](MonadImpl[T, A]{}, from, init, f)
+}
+func FoldRight[T, A any](from <-chan T, init A, f types.BiFunction[T, A, A]) A {
+	return MonadImpl[T, A]{}.FoldRight(from, init, f)
+}
+func Ap[F, T any](fs <-chan types.Function[F, T], from <-chan F) <-chan T {
+	return monad.Ap[<-chan T, F, T](MonadImpl[F, T]{}, fs, from)
+}
+func Map2[A, B, C any](a <-chan A, b <-chan B, f types.BiFunction[A, B, C]) <-chan C {
+	return monad.Map2[<-chan C](MonadImpl[A, types.Function[B, C]]{}, MonadImpl[B, C]{}, a, b, f)
+}
@@ -0,0 +1,115 @@
+package channel
+
+import (
+	"context"
+
+	"github.com/Warashi/go-generics/types"
+)
+
+// BindContext is the context-aware counterpart of MonadImpl.Bind.
+// The returned channel is closed when src is closed or ctx is done; in either case no goroutine is left blocked.
+// Channels returned by f are read until closed or ctx is done, so they should observe ctx as well.
+func BindContext[T, U any](ctx context.Context, src <-chan T, f types.Function[T, <-chan U]) <-chan U {
+	result := make(chan U)
+	go func() {
+		defer close(result)
+		for {
+			var (
+				v  T
+				ok bool
+			)
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok = <-src:
+				if !ok {
+					return
+				}
+			}
+			inner := f.Apply(v)
+			for {
+				var vv U
+				select {
+				case <-ctx.Done():
+					return
+				case vv, ok = <-inner:
+				}
+				if !ok {
+					break
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case result <- vv:
+				}
+			}
+		}
+	}()
+	return result
+}
+
+// PlusContext is the context-aware counterpart of MonadImpl.Plus.
+// It sends every value received from a, then every value received from b.
+func PlusContext[T any](ctx context.Context, a, b <-chan T) <-chan T {
+	result := make(chan T)
+	go func() {
+		defer close(result)
+		for _, ch := range []<-chan T{a, b} {
+			for {
+				var (
+					v  T
+					ok bool
+				)
+				select {
+				case <-ctx.Done():
+					return
+				case v, ok = <-ch:
+				}
+				if !ok {
+					break
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case result <- v:
+				}
+			}
+		}
+	}()
+	return result
+}
+
+func MapContext[F, T any](ctx context.Context, from <-chan F, f types.Function[F, T]) <-chan T {
+	return BindContext[F, T](ctx, from, types.Closure[F, <-chan T](func(value F) <-chan T {
+		return MonadImpl[F, T]{}.Unit(f.Apply(value))
+	}))
+}
+
+func FlatMapContext[F, T any](ctx context.Context, from <-chan F, f types.Function[F, <-chan T]) <-chan T {
+	return BindContext(ctx, from, f)
+}
+
+func FilterContext[T any](ctx context.Context, from <-chan T, f types.Function[T, bool]) <-chan T {
+	return BindContext[T, T](ctx, from, types.Closure[T, <-chan T](func(value T) <-chan T {
+		if !f.Apply(value) {
+			return MonadImpl[T, T]{}.Zero()
+		}
+		return MonadImpl[T, T]{}.Unit(value)
+	}))
+}
+
+// ForEachContext calls f for every value received from from until it is closed or ctx is done.
+// It returns ctx.Err() if it stopped because of ctx.
+func ForEachContext[T any](ctx context.Context, from <-chan T, f types.Consumer[T]) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case v, ok := <-from:
+			if !ok {
+				return nil
+			}
+			f.Accept(v)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package channel_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Warashi/go-generics/channel"
+	"github.com/Warashi/go-generics/types"
+)
+
+// noLeak fails t if more goroutines are running at the end of the test than at the start.
+func noLeak(t *testing.T) {
+	t.Helper()
+	before := runtime.NumGoroutine()
+	t.Cleanup(func() {
+		deadline := time.Now().Add(time.Second)
+		for {
+			after := runtime.NumGoroutine()
+			if after <= before {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Errorf("goroutine leak: %d before, %d after", before, after)
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	})
+}
+
+// endless sends 0, 1, 2, ... until ctx is done.
+func endless(ctx context.Context) <-chan int {
+	ch := make(chan int)
+	go func() {
+		defer close(ch)
+		for i := 0; ; i++ {
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- i:
+			}
+		}
+	}()
+	return ch
+}
+
+func TestMapContext(t *testing.T) {
+	noLeak(t)
+	got := collect(channel.MapContext(context.Background(), of(1, 2, 3), types.Closure[int, string](strconv.Itoa)))
+	if want := []string{"1", "2", "3"}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	t.Run("consumer stops", func(t *testing.T) {
+		noLeak(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := channel.MapContext(ctx, endless(ctx), types.Closure[int, string](strconv.Itoa))
+		<-ch
+		cancel()
+	})
+}
+
+func TestFilterContext(t *testing.T) {
+	noLeak(t)
+	even := types.Closure[int, bool](func(v int) bool { return v%2 == 0 })
+	got := collect(channel.FilterContext(context.Background(), of(1, 2, 3, 4), even))
+	if want := []int{2, 4}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	t.Run("consumer stops", func(t *testing.T) {
+		noLeak(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := channel.FilterContext(ctx, endless(ctx), even)
+		<-ch
+		cancel()
+	})
+}
+
+func TestFlatMapContext(t *testing.T) {
+	noLeak(t)
+	twice := types.Closure[int, <-chan int](func(v int) <-chan int { return of(v, v) })
+	got := collect(channel.FlatMapContext(context.Background(), of(1, 2), twice))
+	if want := []int{1, 1, 2, 2}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	t.Run("consumer stops", func(t *testing.T) {
+		noLeak(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := channel.FlatMapContext(ctx, endless(ctx), types.Closure[int, <-chan int](func(int) <-chan int {
+			return endless(ctx)
+		}))
+		<-ch
+		cancel()
+	})
+	t.Run("source never closes", func(t *testing.T) {
+		noLeak(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := channel.FlatMapContext(ctx, make(chan int), twice)
+		cancel()
+		if got, ok := <-ch; ok {
+			t.Errorf("got %d and %t, want %t", got, ok, false)
+		}
+	})
+}
+
+func TestPlusContext(t *testing.T) {
+	noLeak(t)
+	got := collect(channel.PlusContext(context.Background(), of(1, 2), of(3)))
+	if want := []int{1, 2, 3}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	t.Run("consumer stops", func(t *testing.T) {
+		noLeak(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := channel.PlusContext(ctx, of(1, 2), endless(ctx))
+		<-ch
+		cancel()
+	})
+}
+
+func TestForEachContext(t *testing.T) {
+	noLeak(t)
+	var got []int
+	add := types.ConsumerClosure[int](func(v int) { got = append(got, v) })
+	if err := channel.ForEachContext(context.Background(), of(1, 2, 3), add); err != nil {
+		t.Errorf("ForEachContext() error = %v", err)
+	}
+	if want := []int{1, 2, 3}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	t.Run("context canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if err := channel.ForEachContext(ctx, make(chan int), add); !errors.Is(err, context.Canceled) {
+			t.Errorf("ForEachContext() error = %v, want %v", err, context.Canceled)
+		}
+	})
+}
@@ -0,0 +1,272 @@
+package channel
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	"github.com/Warashi/go-generics/minmax"
+)
+
+// Tee returns n channels which each receive every value received from in.
+// A value is sent to every output before the next one is received, so the slowest consumer applies backpressure.
+// The outputs are closed when in is closed or ctx is done. Tee panics if n is less than 1.
+func Tee[T any](ctx context.Context, in <-chan T, n int) []<-chan T {
+	if n < 1 {
+		panic("non-positive number of outputs for channel.Tee")
+	}
+	outs := make([]chan T, n)
+	for i := range outs {
+		outs[i] = make(chan T)
+	}
+	go func() {
+		defer closeAll(outs)
+		for {
+			var (
+				v  T
+				ok bool
+			)
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok = <-in:
+				if !ok {
+					return
+				}
+			}
+			// send to the outputs in whatever order their consumers are ready.
+			cases := sendCases(ctx, outs, v)
+			for remaining := len(outs); remaining > 0; remaining-- {
+				chosen, _, _ := reflect.Select(cases)
+				if chosen == 0 {
+					return
+				}
+				cases[chosen].Chan = reflect.Value{}
+			}
+		}
+	}()
+	return receiveOnly(outs)
+}
+
+// DistributePolicy decides which output of Distribute receives each value.
+type DistributePolicy int
+
+const (
+	// RoundRobin sends values to the outputs in turn.
+	RoundRobin DistributePolicy = iota
+	// LeastLoaded sends each value to whichever output is ready to receive first, so idle consumers get more values.
+	LeastLoaded
+)
+
+// Distribute returns n channels which together receive every value received from in, each value exactly once.
+// The outputs are closed when in is closed or ctx is done. Distribute panics if n is less than 1.
+func Distribute[T any](ctx context.Context, in <-chan T, n int, policy DistributePolicy) []<-chan T {
+	if n < 1 {
+		panic("non-positive number of outputs for channel.Distribute")
+	}
+	outs := make([]chan T, n)
+	for i := range outs {
+		outs[i] = make(chan T)
+	}
+	go func() {
+		defer closeAll(outs)
+		for i := 0; ; i = (i + 1) % n {
+			var (
+				v  T
+				ok bool
+			)
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok = <-in:
+				if !ok {
+					return
+				}
+			}
+			switch policy {
+			case LeastLoaded:
+				if chosen, _, _ := reflect.Select(sendCases(ctx, outs, v)); chosen == 0 {
+					return
+				}
+			default:
+				select {
+				case <-ctx.Done():
+					return
+				case outs[i] <- v:
+				}
+			}
+		}
+	}()
+	return receiveOnly(outs)
+}
+
+// sendCases returns select cases sending v to each of outs, preceded by a case receiving from ctx.Done().
+func sendCases[T any](ctx context.Context, outs []chan T, v T) []reflect.SelectCase {
+	cases := make([]reflect.SelectCase, 0, len(outs)+1)
+	cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())})
+	rv := reflect.ValueOf(&v).Elem()
+	for _, out := range outs {
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectSend, Chan: reflect.ValueOf(out), Send: rv})
+	}
+	return cases
+}
+
+func closeAll[T any](chs []chan T) {
+	for _, ch := range chs {
+		close(ch)
+	}
+}
+
+func receiveOnly[T any](chs []chan T) []<-chan T {
+	ret := make([]<-chan T, len(chs))
+	for i, ch := range chs {
+		ret[i] = ch
+	}
+	return ret
+}
+
+// BufferPolicy decides what a Broadcaster does when a subscriber's buffer is full.
+type BufferPolicy int
+
+const (
+	// BufferBlock waits for the subscriber, applying backpressure to every other subscriber.
+	BufferBlock BufferPolicy = iota
+	// BufferDropNewest drops the value being broadcast.
+	BufferDropNewest
+	// BufferDropOldest drops the oldest buffered value to make room for the value being broadcast.
+	BufferDropOldest
+)
+
+type subscriber[T any] struct {
+	ch     chan T
+	policy BufferPolicy
+	done   chan struct{}
+	once   sync.Once
+
+	mu     sync.Mutex
+	closed bool
+}
+
+func (s *subscriber[T]) send(ctx context.Context, v T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	switch s.policy {
+	case BufferDropNewest:
+		select {
+		case s.ch <- v:
+		default:
+		}
+	case BufferDropOldest:
+		for {
+			select {
+			case s.ch <- v:
+				return
+			default:
+			}
+			if cap(s.ch) == 0 {
+				return
+			}
+			// only send writes to s.ch, so there is room once a value is evicted.
+			select {
+			case <-s.ch:
+			default:
+			}
+		}
+	default:
+		select {
+		case <-ctx.Done():
+		case <-s.done:
+		case s.ch <- v:
+		}
+	}
+}
+
+func (s *subscriber[T]) close() {
+	s.once.Do(func() {
+		// unblock a pending send before taking the lock it holds.
+		close(s.done)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.closed = true
+		close(s.ch)
+	})
+}
+
+// Broadcaster sends every value received from its source to every current subscriber.
+// Subscribers can join and leave at any time; they only receive values broadcast while they are subscribed.
+type Broadcaster[T any] struct {
+	mu          sync.Mutex
+	subscribers map[*subscriber[T]]struct{}
+	done        bool
+}
+
+// NewBroadcaster starts broadcasting the values received from in until it is closed or ctx is done,
+// at which point every subscription is closed.
+// Values received while there are no subscribers are dropped.
+func NewBroadcaster[T any](ctx context.Context, in <-chan T) *Broadcaster[T] {
+	b := &Broadcaster[T]{subscribers: make(map[*subscriber[T]]struct{})}
+	go func() {
+		defer b.stop()
+		for {
+			var (
+				v  T
+				ok bool
+			)
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok = <-in:
+				if !ok {
+					return
+				}
+			}
+			for _, s := range b.snapshot() {
+				s.send(ctx, v)
+			}
+		}
+	}()
+	return b
+}
+
+func (b *Broadcaster[T]) snapshot() []*subscriber[T] {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	subs := make([]*subscriber[T], 0, len(b.subscribers))
+	for s := range b.subscribers {
+		subs = append(subs, s)
+	}
+	return subs
+}
+
+func (b *Broadcaster[T]) stop() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.done = true
+	for s := range b.subscribers {
+		s.close()
+	}
+	clear(b.subscribers)
+}
+
+// Subscribe returns a channel buffering up to size values for the new subscriber, handled according to policy,
+// and a function which unsubscribes and closes the channel. The function may be called more than once.
+// If the Broadcaster has already stopped, the returned channel is closed.
+func (b *Broadcaster[T]) Subscribe(size int, policy BufferPolicy) (<-chan T, func()) {
+	s := &subscriber[T]{ch: make(chan T, minmax.Max(size, 0)), policy: policy, done: make(chan struct{})}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.done {
+		s.close()
+		return s.ch, func() {}
+	}
+	b.subscribers[s] = struct{}{}
+	return s.ch, func() {
+		b.mu.Lock()
+		delete(b.subscribers, s)
+		b.mu.Unlock()
+		s.close()
+	}
+}
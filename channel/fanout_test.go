@@ -0,0 +1,199 @@
+package channel_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/Warashi/go-generics/channel"
+)
+
+// collectAll collects every channel of chs concurrently.
+func collectAll[T any](chs []<-chan T) [][]T {
+	ret := make([][]T, len(chs))
+	var wg sync.WaitGroup
+	for i, ch := range chs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ret[i] = collect(ch)
+		}()
+	}
+	wg.Wait()
+	return ret
+}
+
+func TestTee(t *testing.T) {
+	noLeak(t)
+	got := collectAll(channel.Tee(context.Background(), of(1, 2, 3), 3))
+	if want := [][]int{{1, 2, 3}, {1, 2, 3}, {1, 2, 3}}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	t.Run("any receive order", func(t *testing.T) {
+		noLeak(t)
+		outs := channel.Tee(context.Background(), of(1, 2), 2)
+		for _, want := range []int{1, 2} {
+			if got := []int{<-outs[1], <-outs[0]}; !cmp.Equal(got, []int{want, want}) {
+				t.Errorf("got %v, want %v", got, []int{want, want})
+			}
+		}
+	})
+	t.Run("no outputs", func(t *testing.T) {
+		for _, n := range []int{0, -1} {
+			func() {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("Tee(n = %d) did not panic", n)
+					}
+				}()
+				channel.Tee(context.Background(), of(1), n)
+			}()
+		}
+	})
+	t.Run("context canceled", func(t *testing.T) {
+		noLeak(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		outs := channel.Tee(ctx, endless(ctx), 2)
+		<-outs[0]
+		cancel()
+		for _, out := range outs {
+			for range out {
+			}
+		}
+	})
+}
+
+func TestDistribute(t *testing.T) {
+	t.Run("round robin", func(t *testing.T) {
+		noLeak(t)
+		got := collectAll(channel.Distribute(context.Background(), of(0, 1, 2, 3, 4, 5, 6), 3, channel.RoundRobin))
+		if want := [][]int{{0, 3, 6}, {1, 4}, {2, 5}}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+	t.Run("least loaded", func(t *testing.T) {
+		noLeak(t)
+		outs := channel.Distribute(context.Background(), of(0, 1, 2, 3, 4, 5, 6), 3, channel.LeastLoaded)
+		var got []int
+		for _, v := range collectAll(outs) {
+			got = append(got, v...)
+		}
+		opt := cmpopts.SortSlices(func(i, j int) bool { return i < j })
+		if want := []int{0, 1, 2, 3, 4, 5, 6}; !cmp.Equal(got, want, opt) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+	t.Run("least loaded skips busy consumer", func(t *testing.T) {
+		noLeak(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		outs := channel.Distribute(ctx, of(0, 1, 2), 2, channel.LeastLoaded)
+		// nobody receives from outs[1].
+		if got, want := collect(outs[0]), []int{0, 1, 2}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+	t.Run("no outputs", func(t *testing.T) {
+		for _, n := range []int{0, -1} {
+			func() {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("Distribute(n = %d) did not panic", n)
+					}
+				}()
+				channel.Distribute(context.Background(), of(1), n, channel.RoundRobin)
+			}()
+		}
+	})
+	t.Run("context canceled", func(t *testing.T) {
+		noLeak(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		outs := channel.Distribute(ctx, endless(ctx), 2, channel.RoundRobin)
+		<-outs[0]
+		cancel()
+	})
+}
+
+func TestBroadcaster(t *testing.T) {
+	t.Run("every subscriber", func(t *testing.T) {
+		noLeak(t)
+		in := make(chan int)
+		b := channel.NewBroadcaster(context.Background(), in)
+		a, _ := b.Subscribe(0, channel.BufferBlock)
+		c, _ := b.Subscribe(0, channel.BufferBlock)
+		go func() {
+			defer close(in)
+			for i := range 3 {
+				in <- i
+			}
+		}()
+		got := collectAll([]<-chan int{a, c})
+		if want := [][]int{{0, 1, 2}, {0, 1, 2}}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+		if ch, _ := b.Subscribe(1, channel.BufferBlock); len(collect(ch)) != 0 {
+			t.Errorf("subscription after stop is not closed and empty")
+		}
+	})
+	t.Run("unsubscribe", func(t *testing.T) {
+		noLeak(t)
+		in := make(chan int)
+		b := channel.NewBroadcaster(context.Background(), in)
+		defer close(in)
+		stalled, unsubscribe := b.Subscribe(0, channel.BufferBlock)
+		in <- 1
+		// the broadcaster is now blocked on stalled.
+		unsubscribe()
+		unsubscribe()
+		if _, ok := <-stalled; ok {
+			t.Errorf("channel is not closed after unsubscribe")
+		}
+		ch, unsubscribe := b.Subscribe(0, channel.BufferBlock)
+		defer unsubscribe()
+		in <- 2
+		if got := <-ch; got != 2 {
+			t.Errorf("got %d, want %d", got, 2)
+		}
+	})
+	t.Run("policies", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			size   int
+			policy channel.BufferPolicy
+			want   []int
+		}{
+			{name: "drop newest", size: 2, policy: channel.BufferDropNewest, want: []int{0, 1}},
+			{name: "drop oldest", size: 2, policy: channel.BufferDropOldest, want: []int{3, 4}},
+			{name: "drop oldest unbuffered", size: 0, policy: channel.BufferDropOldest, want: nil},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				noLeak(t)
+				in := make(chan int)
+				b := channel.NewBroadcaster(context.Background(), in)
+				ch, _ := b.Subscribe(tt.size, tt.policy)
+				for i := range 5 {
+					in <- i
+				}
+				close(in)
+				if got := collect(ch); !cmp.Equal(got, tt.want) {
+					t.Errorf("got %v, want %v", got, tt.want)
+				}
+			})
+		}
+	})
+	t.Run("context canceled", func(t *testing.T) {
+		noLeak(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		b := channel.NewBroadcaster(ctx, endless(ctx))
+		ch, _ := b.Subscribe(0, channel.BufferBlock)
+		<-ch
+		cancel()
+		for range ch {
+		}
+	})
+}
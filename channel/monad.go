@@ -6,8 +6,10 @@ import (
 )
 
 var (
-	_ monad.Monad[int, string, <-chan int, <-chan string]         = MonadImpl[int, string]{}
-	_ monad.AdditiveMonad[int, string, <-chan int, <-chan string] = MonadImpl[int, string]{}
+	_ monad.Monad[int, string, <-chan int, <-chan string]                                           = MonadImpl[int, string]{}
+	_ monad.AdditiveMonad[int, string, <-chan int, <-chan string]                                   = MonadImpl[int, string]{}
+	_ monad.Foldable[int, string, <-chan int]                                                       = MonadImpl[int, string]{}
+	_ monad.Applicative[int, string, <-chan int, <-chan string, <-chan types.Function[int, string]] = MonadImpl[int, string]{}
 )
 
 type MonadImpl[T, U any] struct{}
@@ -19,6 +21,8 @@ func (MonadImpl[T, U]) Unit(value U) <-chan U {
 	return ch
 }
 
+// Bind blocks forever if the consumer stops receiving before the result is closed.
+// Use BindContext when the consumer may give up early.
 func (MonadImpl[T, U]) Bind(src <-chan T, f types.Function[T, <-chan U]) <-chan U {
 	result := make(chan U)
 	go func() {
@@ -38,6 +42,7 @@ func (MonadImpl[T, U]) Zero() <-chan U {
 	return ch
 }
 
+// Plus has the same caveat as Bind; see PlusContext.
 func (MonadImpl[T, U]) Plus(a, b <-chan T) <-chan T {
 	ch := make(chan T)
 	go func() {
@@ -51,3 +56,43 @@ func (MonadImpl[T, U]) Plus(a, b <-chan T) <-chan T {
 	}()
 	return ch
 }
+
+func (MonadImpl[T, U]) FoldLeft(src <-chan T, init U, f types.BiFunction[U, T, U]) U {
+	acc := init
+	for v := range src {
+		acc = f.Apply(acc, v)
+	}
+	return acc
+}
+
+func (MonadImpl[T, U]) FoldRight(src <-chan T, init U, f types.BiFunction[T, U, U]) U {
+	var values []T
+	for v := range src {
+		values = append(values, v)
+	}
+	acc := init
+	for i := len(values) - 1; i >= 0; i-- {
+		acc = f.Apply(values[i], acc)
+	}
+	return acc
+}
+
+// Ap applies every function received from fs to every value received from src.
+// src is drained and buffered before the first function is applied.
+// Like Bind, it blocks forever if the consumer stops receiving before the result is closed.
+func (MonadImpl[T, U]) Ap(fs <-chan types.Function[T, U], src <-chan T) <-chan U {
+	result := make(chan U)
+	go func() {
+		defer close(result)
+		var values []T
+		for v := range src {
+			values = append(values, v)
+		}
+		for f := range fs {
+			for _, v := range values {
+				result <- f.Apply(v)
+			}
+		}
+	}()
+	return result
+}
@@ -0,0 +1,67 @@
+package channel_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/Warashi/go-generics/channel"
+	"github.com/Warashi/go-generics/monad/monadtest"
+	"github.com/Warashi/go-generics/types"
+)
+
+func TestMonadLaws(t *testing.T) {
+	gen := monadtest.Generator[int, <-chan int]{
+		Value: func(r *rand.Rand) int { return r.Intn(10) },
+		Monad: func(r *rand.Rand) <-chan int {
+			v := make([]int, r.Intn(4))
+			for i := range v {
+				v[i] = r.Intn(10)
+			}
+			return of(v...)
+		},
+		Function: func(r *rand.Rand) types.Function[int, <-chan int] {
+			k := r.Intn(5)
+			return types.Closure[int, <-chan int](func(x int) <-chan int {
+				v := make([]int, (x+k)%3)
+				for i := range v {
+					v[i] = x + k + i
+				}
+				return of(v...)
+			})
+		},
+	}
+	equal := func(a, b <-chan int) bool { return cmp.Equal(collect(a), collect(b), cmpopts.EquateEmpty()) }
+
+	monadtest.TestAdditiveMonad[int, <-chan int](t, channel.MonadImpl[int, int]{}, gen, equal)
+}
+
+func TestAp(t *testing.T) {
+	inc := types.Closure[int, int](func(v int) int { return v + 1 })
+	double := types.Closure[int, int](func(v int) int { return v * 2 })
+
+	noLeak(t)
+	got := collect(channel.Ap(of[types.Function[int, int]](inc, double), of(1, 2)))
+	if want := []int{2, 3, 2, 4}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	t.Run("function channel closes early", func(t *testing.T) {
+		noLeak(t)
+		fs := make(chan types.Function[int, int])
+		close(fs)
+		// src blocks until every value is received, so Ap has to drain it.
+		src := make(chan int)
+		go func() {
+			defer close(src)
+			for i := 0; i < 100; i++ {
+				src <- i
+			}
+		}()
+		if got := collect(channel.Ap[int, int](fs, src)); len(got) != 0 {
+			t.Errorf("got %v, want empty", got)
+		}
+	})
+}
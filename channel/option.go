@@ -0,0 +1,24 @@
+package channel
+
+import "github.com/Warashi/go-generics/clock"
+
+type Option func(c *config)
+
+// WithClock sets the clock measuring time for the stage. The default is clock.Real{}.
+func WithClock(clk clock.Clock) Option {
+	return func(c *config) {
+		c.clock = clk
+	}
+}
+
+type config struct {
+	clock clock.Clock
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{clock: clock.Real{}}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
@@ -0,0 +1,128 @@
+package channel
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Warashi/go-generics/minmax"
+	"github.com/Warashi/go-generics/types"
+)
+
+type parallelJob[T, U any] struct {
+	value T
+	slot  chan<- U
+}
+
+// ParallelMap is like Map but applies f on workers goroutines.
+// If ordered is true, the results are sent in the order of in; otherwise they are sent as soon as they are ready.
+// At most about 2*workers values are in flight when ordered, and workers values otherwise.
+// The returned channel is closed when in is closed and every result has been sent, or ctx is done;
+// in either case every internal goroutine stops.
+// workers less than 1 is treated as 1.
+func ParallelMap[T, U any](ctx context.Context, in <-chan T, f types.Function[T, U], workers int, ordered bool) <-chan U {
+	workers = minmax.Max(workers, 1)
+	if ordered {
+		return parallelMapOrdered(ctx, in, f, workers)
+	}
+	return parallelMapUnordered(ctx, in, f, workers)
+}
+
+func parallelMapUnordered[T, U any](ctx context.Context, in <-chan T, f types.Function[T, U], workers int) <-chan U {
+	result := make(chan U)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for {
+				var (
+					v  T
+					ok bool
+				)
+				select {
+				case <-ctx.Done():
+					return
+				case v, ok = <-in:
+					if !ok {
+						return
+					}
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case result <- f.Apply(v):
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(result)
+	}()
+	return result
+}
+
+// parallelMapOrdered reorders the results with a queue of one-shot slots, one per value of in.
+// The feeder queues a slot before handing the value to a worker, and the emitter waits on the slots in queue order.
+func parallelMapOrdered[T, U any](ctx context.Context, in <-chan T, f types.Function[T, U], workers int) <-chan U {
+	result := make(chan U)
+	jobs := make(chan parallelJob[T, U])
+	slots := make(chan chan U, workers)
+
+	go func() {
+		defer close(jobs)
+		defer close(slots)
+		for {
+			var (
+				v  T
+				ok bool
+			)
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok = <-in:
+				if !ok {
+					return
+				}
+			}
+			slot := make(chan U, 1)
+			select {
+			case <-ctx.Done():
+				return
+			case slots <- slot:
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case jobs <- parallelJob[T, U]{value: v, slot: slot}:
+			}
+		}
+	}()
+
+	for w := 0; w < workers; w++ {
+		go func() {
+			for job := range jobs {
+				// slot is buffered, so this never blocks.
+				job.slot <- f.Apply(job.value)
+			}
+		}()
+	}
+
+	go func() {
+		defer close(result)
+		for slot := range slots {
+			var v U
+			select {
+			case <-ctx.Done():
+				return
+			case v = <-slot:
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case result <- v:
+			}
+		}
+	}()
+	return result
+}
@@ -0,0 +1,95 @@
+package channel_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/Warashi/go-generics/channel"
+	"github.com/Warashi/go-generics/types"
+)
+
+func TestParallelMap(t *testing.T) {
+	values := make([]int, 100)
+	want := make([]int, 100)
+	for i := range values {
+		values[i] = i
+		want[i] = i * 2
+	}
+	// later values finish first, so that ordering has to be restored.
+	double := types.Closure[int, int](func(v int) int {
+		time.Sleep(time.Duration(100-v) * 10 * time.Microsecond)
+		return v * 2
+	})
+
+	t.Run("ordered", func(t *testing.T) {
+		noLeak(t)
+		got := collect(channel.ParallelMap(context.Background(), of(values...), double, 8, true))
+		if !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+	t.Run("unordered", func(t *testing.T) {
+		noLeak(t)
+		got := collect(channel.ParallelMap(context.Background(), of(values...), double, 8, false))
+		opt := cmpopts.SortSlices(func(i, j int) bool { return i < j })
+		if !cmp.Equal(got, want, opt) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+	t.Run("workers", func(t *testing.T) {
+		for _, ordered := range []bool{true, false} {
+			var running, peak atomic.Int32
+			f := types.Closure[int, int](func(v int) int {
+				n := running.Add(1)
+				defer running.Add(-1)
+				for {
+					p := peak.Load()
+					if n <= p || peak.CompareAndSwap(p, n) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond)
+				return v
+			})
+			collect(channel.ParallelMap(context.Background(), of(values...), f, 4, ordered))
+			if got := peak.Load(); got > 4 {
+				t.Errorf("ordered=%t: %d calls of f ran at once, want at most %d", ordered, got, 4)
+			}
+		}
+	})
+	t.Run("bounded", func(t *testing.T) {
+		for _, ordered := range []bool{true, false} {
+			noLeak(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			var read atomic.Int32
+			in := channel.MapContext(ctx, endless(ctx), types.Closure[int, int](func(v int) int {
+				read.Add(1)
+				return v
+			}))
+			ch := channel.ParallelMap(ctx, in, types.Closure[int, int](func(v int) int { return v }), 4, ordered)
+			<-ch
+			time.Sleep(10 * time.Millisecond)
+			// 2*workers in flight, plus one held by each stage of the pipeline.
+			if got := read.Load(); got > 2*4+4 {
+				t.Errorf("ordered=%t: %d values read while the consumer is stalled, want at most %d", ordered, got, 2*4+4)
+			}
+			cancel()
+		}
+	})
+	t.Run("context canceled", func(t *testing.T) {
+		for _, ordered := range []bool{true, false} {
+			noLeak(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			ch := channel.ParallelMap(ctx, endless(ctx), double, 4, ordered)
+			<-ch
+			cancel()
+			for range ch {
+			}
+		}
+	})
+}
@@ -0,0 +1,160 @@
+package channel
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/Warashi/go-generics/minmax"
+)
+
+// Throttle sends the values received from in at no more than rate values per second, delaying them as needed.
+// It is a token bucket holding up to burst tokens, initially full, so up to burst values may be sent at once.
+// burst less than 1 is treated as 1. The returned channel is closed when in is closed or ctx is done.
+// Throttle panics if rate is not a positive finite number.
+func Throttle[T any](ctx context.Context, in <-chan T, rate float64, burst int, opts ...Option) <-chan T {
+	if !(rate > 0) || math.IsInf(rate, 1) {
+		panic("non-positive or non-finite rate for channel.Throttle")
+	}
+	c := newConfig(opts)
+	capacity := float64(minmax.Max(burst, 1))
+	result := make(chan T)
+	go func() {
+		defer close(result)
+		tokens, last := capacity, c.clock.Now()
+		refill := func() {
+			now := c.clock.Now()
+			tokens = minmax.Min(capacity, tokens+now.Sub(last).Seconds()*rate)
+			last = now
+		}
+		for {
+			var (
+				v  T
+				ok bool
+			)
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok = <-in:
+				if !ok {
+					return
+				}
+			}
+			refill()
+			if tokens < 1 {
+				timer := c.clock.NewTimer(time.Duration((1 - tokens) / rate * float64(time.Second)))
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return
+				case <-timer.C():
+				}
+				refill()
+				// the wait is rounded down to a nanosecond, which may leave the bucket just short of a token.
+				tokens = minmax.Max(tokens, 1)
+			}
+			tokens--
+			select {
+			case <-ctx.Done():
+				return
+			case result <- v:
+			}
+		}
+	}()
+	return result
+}
+
+// Debounce sends the latest value received from in once no other value has been received for quiet.
+// A pending value is sent immediately when in is closed. A non-positive quiet sends every value as soon as it is received.
+// The returned channel is closed when in is closed or ctx is done.
+func Debounce[T any](ctx context.Context, in <-chan T, quiet time.Duration, opts ...Option) <-chan T {
+	c := newConfig(opts)
+	result := make(chan T)
+	go func() {
+		defer close(result)
+		timer := c.clock.NewTimer(quiet)
+		timer.Stop()
+		defer timer.Stop()
+		var (
+			latest  T
+			pending bool
+		)
+		send := func() bool {
+			pending = false
+			select {
+			case <-ctx.Done():
+				return false
+			case result <- latest:
+				return true
+			}
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C():
+				if !send() {
+					return
+				}
+			case v, ok := <-in:
+				if !ok {
+					if pending {
+						send()
+					}
+					return
+				}
+				latest, pending = v, true
+				if quiet <= 0 {
+					if !send() {
+						return
+					}
+					continue
+				}
+				timer.Reset(quiet)
+			}
+		}
+	}()
+	return result
+}
+
+// Sample sends, every interval, the latest value received from in since the previous one, if any.
+// A value received after the last tick is dropped when in is closed. The returned channel is closed when in is closed or ctx is done.
+// Sample panics if interval is not positive.
+func Sample[T any](ctx context.Context, in <-chan T, interval time.Duration, opts ...Option) <-chan T {
+	if interval <= 0 {
+		panic("non-positive interval for channel.Sample")
+	}
+	c := newConfig(opts)
+	result := make(chan T)
+	go func() {
+		defer close(result)
+		ticker := c.clock.NewTicker(interval)
+		defer ticker.Stop()
+		var (
+			latest  T
+			pending bool
+		)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C():
+				if !pending {
+					continue
+				}
+				pending = false
+				select {
+				case <-ctx.Done():
+					return
+				case result <- latest:
+				}
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+				latest, pending = v, true
+			}
+		}
+	}()
+	return result
+}
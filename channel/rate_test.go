@@ -0,0 +1,186 @@
+package channel_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/Warashi/go-generics/channel"
+	"github.com/Warashi/go-generics/clock"
+)
+
+// none fails t if a value is ready on ch.
+func none[T any](t *testing.T, ch <-chan T) {
+	t.Helper()
+	select {
+	case v := <-ch:
+		t.Errorf("got %v, want nothing", v)
+	default:
+	}
+}
+
+func TestThrottle(t *testing.T) {
+	noLeak(t)
+	clk := clock.NewFake(epoch)
+	ch := channel.Throttle(context.Background(), of(1, 2, 3, 4, 5), 2, 2, channel.WithClock(clk))
+	// the bucket starts full.
+	for _, want := range []int{1, 2} {
+		if got := <-ch; got != want {
+			t.Errorf("got %d, want %d", got, want)
+		}
+	}
+	for _, want := range []int{3, 4} {
+		clk.BlockUntil(1)
+		none(t, ch)
+		clk.Advance(500 * time.Millisecond)
+		if got := <-ch; got != want {
+			t.Errorf("got %d, want %d", got, want)
+		}
+	}
+	// the last value waits for a token whether or not its timer has started yet.
+	clk.Advance(time.Hour)
+	if got := collect(ch); !cmp.Equal(got, []int{5}) {
+		t.Errorf("got %v, want %v", got, []int{5})
+	}
+
+	t.Run("context canceled", func(t *testing.T) {
+		noLeak(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		clk := clock.NewFake(epoch)
+		ch := channel.Throttle(ctx, endless(ctx), 1, 1, channel.WithClock(clk))
+		<-ch
+		clk.BlockUntil(1)
+		cancel()
+		for range ch {
+		}
+	})
+}
+
+func TestThrottle_invalidRate(t *testing.T) {
+	for _, rate := range []float64{0, -1, math.NaN(), math.Inf(1)} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Throttle(rate = %v) did not panic", rate)
+				}
+			}()
+			channel.Throttle(context.Background(), of(1), rate, 1)
+		}()
+	}
+}
+
+func TestDebounce(t *testing.T) {
+	noLeak(t)
+	clk := clock.NewFake(epoch)
+	in := make(chan int)
+	ch := channel.Debounce(context.Background(), in, time.Second, channel.WithClock(clk))
+	in <- 1
+	clk.BlockUntilScheduled(epoch.Add(time.Second))
+	clk.Advance(500 * time.Millisecond)
+	in <- 2
+	// 2 restarts the quiet period.
+	clk.BlockUntilScheduled(epoch.Add(1500 * time.Millisecond))
+	clk.Advance(500 * time.Millisecond)
+	none(t, ch)
+	clk.Advance(500 * time.Millisecond)
+	if got := <-ch; got != 2 {
+		t.Errorf("got %d, want %d", got, 2)
+	}
+	in <- 3
+	close(in)
+	if got := collect(ch); !cmp.Equal(got, []int{3}) {
+		t.Errorf("got %v, want %v", got, []int{3})
+	}
+
+	t.Run("no quiet", func(t *testing.T) {
+		noLeak(t)
+		for _, quiet := range []time.Duration{0, -time.Second} {
+			ch := channel.Debounce(context.Background(), of(1, 2, 3), quiet, channel.WithClock(clock.NewFake(epoch)))
+			if got := collect(ch); !cmp.Equal(got, []int{1, 2, 3}) {
+				t.Errorf("quiet = %v: got %v, want %v", quiet, got, []int{1, 2, 3})
+			}
+		}
+	})
+	t.Run("context canceled", func(t *testing.T) {
+		noLeak(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := channel.Debounce(ctx, endless(ctx), time.Second, channel.WithClock(clock.NewFake(epoch)))
+		cancel()
+		for range ch {
+		}
+	})
+}
+
+func TestSample(t *testing.T) {
+	noLeak(t)
+	clk := clock.NewFake(epoch)
+	in := make(chan int)
+	ch := channel.Sample(context.Background(), in, time.Second, channel.WithClock(clk))
+	clk.BlockUntil(1)
+	in <- 1
+	in <- 2
+	clk.Advance(time.Second)
+	if got := <-ch; got != 2 {
+		t.Errorf("got %d, want %d", got, 2)
+	}
+	// nothing is sent for an interval without values.
+	clk.Advance(time.Second)
+	in <- 3
+	clk.Advance(time.Second)
+	if got := <-ch; got != 3 {
+		t.Errorf("got %d, want %d", got, 3)
+	}
+	in <- 4
+	close(in)
+	if got := collect(ch); len(got) != 0 {
+		t.Errorf("got %v, want nothing", got)
+	}
+
+	t.Run("non-positive interval", func(t *testing.T) {
+		for _, interval := range []time.Duration{0, -time.Second} {
+			func() {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("Sample(interval = %v) did not panic", interval)
+					}
+				}()
+				channel.Sample(context.Background(), of(1), interval)
+			}()
+		}
+	})
+	t.Run("context canceled", func(t *testing.T) {
+		noLeak(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := channel.Sample(ctx, endless(ctx), time.Second, channel.WithClock(clock.NewFake(epoch)))
+		cancel()
+		for range ch {
+		}
+	})
+}
+
+func TestRateComposition(t *testing.T) {
+	noLeak(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	clk := clock.NewFake(epoch)
+	merged := channel.Merge(ctx, of(1, 2), of(3, 4))
+	out := channel.OrDone(ctx, channel.Throttle(ctx, merged, 1, 1, channel.WithClock(clk)))
+	got := []int{<-out}
+	for range 3 {
+		clk.BlockUntil(1)
+		none(t, out)
+		clk.Advance(time.Second)
+		got = append(got, <-out)
+	}
+	if _, ok := <-out; ok {
+		t.Errorf("channel is not closed after every value")
+	}
+	opt := cmpopts.SortSlices(func(i, j int) bool { return i < j })
+	if want := []int{1, 2, 3, 4}; !cmp.Equal(got, want, opt) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,100 @@
+package channel
+
+import (
+	"context"
+
+	"github.com/Warashi/go-generics/types"
+)
+
+// TryFlatMap is the fallible counterpart of FlatMapContext.
+// It stops at the first error returned by f, or when ctx is done, and sends the error or ctx.Err() to the returned error channel,
+// which is closed after the value channel. Receiving from it after draining the values yields nil on success.
+// After an error, from is drained in the background until it is closed or ctx is done, so that its producer is never blocked.
+func TryFlatMap[F, T any](ctx context.Context, from <-chan F, f types.FallibleFunction[F, <-chan T]) (<-chan T, <-chan error) {
+	result := make(chan T)
+	errc := make(chan error, 1)
+	go func() {
+		err := tryFlatMap(ctx, from, f, result)
+		close(result)
+		errc <- err
+		close(errc)
+		if err != nil {
+			drain(ctx, from)
+		}
+	}()
+	return result, errc
+}
+
+func tryFlatMap[F, T any](ctx context.Context, from <-chan F, f types.FallibleFunction[F, <-chan T], result chan<- T) error {
+	for {
+		var (
+			v  F
+			ok bool
+		)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case v, ok = <-from:
+			if !ok {
+				return nil
+			}
+		}
+		inner, err := f.Apply(v)
+		if err != nil {
+			return err
+		}
+		for {
+			var vv T
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case vv, ok = <-inner:
+			}
+			if !ok {
+				break
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case result <- vv:
+			}
+		}
+	}
+}
+
+// drain receives from ch until it is closed or ctx is done.
+func drain[T any](ctx context.Context, ch <-chan T) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case _, ok := <-ch:
+			if !ok {
+				return
+			}
+		}
+	}
+}
+
+func TryMap[F, T any](ctx context.Context, from <-chan F, f types.FallibleFunction[F, T]) (<-chan T, <-chan error) {
+	return TryFlatMap[F, T](ctx, from, types.FallibleClosure[F, <-chan T](func(value F) (<-chan T, error) {
+		v, err := f.Apply(value)
+		if err != nil {
+			return nil, err
+		}
+		return MonadImpl[F, T]{}.Unit(v), nil
+	}))
+}
+
+func TryFilter[T any](ctx context.Context, from <-chan T, f types.FallibleFunction[T, bool]) (<-chan T, <-chan error) {
+	return TryFlatMap[T, T](ctx, from, types.FallibleClosure[T, <-chan T](func(value T) (<-chan T, error) {
+		ok, err := f.Apply(value)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return MonadImpl[T, T]{}.Zero(), nil
+		}
+		return MonadImpl[T, T]{}.Unit(value), nil
+	}))
+}
@@ -0,0 +1,124 @@
+package channel_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Warashi/go-generics/channel"
+	"github.com/Warashi/go-generics/types"
+)
+
+var errTry = errors.New("try")
+
+// produce sends 0 to n-1 on an unbuffered channel, so the producer blocks unless every value is received.
+func produce(n int) <-chan int {
+	ch := make(chan int)
+	go func() {
+		defer close(ch)
+		for i := 0; i < n; i++ {
+			ch <- i
+		}
+	}()
+	return ch
+}
+
+func TestTryMap(t *testing.T) {
+	atoi := types.FallibleClosure[string, int](strconv.Atoi)
+	tests := []struct {
+		name    string
+		in      []string
+		want    []int
+		wantErr bool
+	}{
+		{name: "ok", in: []string{"1", "2"}, want: []int{1, 2}},
+		{name: "error", in: []string{"1", "x", "2"}, want: []int{1}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, errc := channel.TryMap[string, int](context.Background(), of(tt.in...), atoi)
+			got := collect(out)
+			if err := <-errc; (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTry_error(t *testing.T) {
+	failAt := func(n int) func(v int) error {
+		return func(v int) error {
+			if v == n {
+				return errTry
+			}
+			return nil
+		}
+	}
+	tests := []struct {
+		name string
+		try  func(ctx context.Context, in <-chan int, fail int) (<-chan int, <-chan error)
+		want []int
+		fail int
+	}{
+		{
+			name: "TryMap",
+			try: func(ctx context.Context, in <-chan int, fail int) (<-chan int, <-chan error) {
+				return channel.TryMap[int, int](ctx, in, types.FallibleClosure[int, int](func(v int) (int, error) {
+					return v, failAt(fail)(v)
+				}))
+			},
+			want: []int{0, 1},
+			fail: 2,
+		},
+		{
+			name: "TryFilter",
+			try: func(ctx context.Context, in <-chan int, fail int) (<-chan int, <-chan error) {
+				return channel.TryFilter[int](ctx, in, types.FallibleClosure[int, bool](func(v int) (bool, error) {
+					return v%2 == 0, failAt(fail)(v)
+				}))
+			},
+			want: []int{0, 2},
+			fail: 3,
+		},
+		{
+			name: "TryFlatMap",
+			try: func(ctx context.Context, in <-chan int, fail int) (<-chan int, <-chan error) {
+				return channel.TryFlatMap[int, int](ctx, in, types.FallibleClosure[int, <-chan int](func(v int) (<-chan int, error) {
+					return of(v, v), failAt(fail)(v)
+				}))
+			},
+			want: []int{0, 0},
+			fail: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			noLeak(t)
+			out, errc := tt.try(context.Background(), produce(100), tt.fail)
+			if got := collect(out); !cmp.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			if err := <-errc; !errors.Is(err, errTry) {
+				t.Errorf("error = %v, want %v", err, errTry)
+			}
+		})
+		t.Run(tt.name+" context canceled", func(t *testing.T) {
+			noLeak(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			out, errc := tt.try(ctx, endless(ctx), -1)
+			<-out
+			cancel()
+			for range out {
+			}
+			if err := <-errc; !errors.Is(err, context.Canceled) {
+				t.Errorf("error = %v, want %v", err, context.Canceled)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package channel
+
+import (
+	"context"
+
+	"github.com/Warashi/go-generics/optional"
+	"github.com/Warashi/go-generics/types"
+)
+
+// ZipWith combines values received from a and b pairwise with f.
+// The returned channel is closed when either a or b is closed, or ctx is done.
+func ZipWith[A, B, C any](ctx context.Context, a <-chan A, b <-chan B, f types.BiFunction[A, B, C]) <-chan C {
+	ret := make(chan C)
+	go func() {
+		defer close(ret)
+		for {
+			var (
+				va A
+				vb B
+				ok bool
+			)
+			select {
+			case <-ctx.Done():
+				return
+			case va, ok = <-a:
+				if !ok {
+					return
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case vb, ok = <-b:
+				if !ok {
+					return
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case ret <- f.Apply(va, vb):
+			}
+		}
+	}()
+	return ret
+}
+
+func Zip[A, B any](ctx context.Context, a <-chan A, b <-chan B) <-chan types.Pair[A, B] {
+	return ZipWith[A, B, types.Pair[A, B]](ctx, a, b, types.BiClosure[A, B, types.Pair[A, B]](types.NewPair[A, B]))
+}
+
+// ZipLongest pairs values received from a and b until both are closed.
+// Once one of them is closed, its side of the pairs is empty.
+func ZipLongest[A, B any](ctx context.Context, a <-chan A, b <-chan B) <-chan types.Pair[optional.Optional[A], optional.Optional[B]] {
+	ret := make(chan types.Pair[optional.Optional[A], optional.Optional[B]])
+	go func() {
+		defer close(ret)
+		for a != nil || b != nil {
+			var p types.Pair[optional.Optional[A], optional.Optional[B]]
+			if a != nil {
+				select {
+				case <-ctx.Done():
+					return
+				case v, ok := <-a:
+					if ok {
+						p.First = optional.New(v)
+					} else {
+						a = nil
+					}
+				}
+			}
+			if b != nil {
+				select {
+				case <-ctx.Done():
+					return
+				case v, ok := <-b:
+					if ok {
+						p.Second = optional.New(v)
+					} else {
+						b = nil
+					}
+				}
+			}
+			if p.First.IsEmpty() && p.Second.IsEmpty() {
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case ret <- p:
+			}
+		}
+	}()
+	return ret
+}
+
+// Unzip splits pairs received from in into two channels.
+// Each pair is sent to the first channel and then to the second one, so both must be received from concurrently.
+func Unzip[A, B any](ctx context.Context, in <-chan types.Pair[A, B]) (<-chan A, <-chan B) {
+	reta, retb := make(chan A), make(chan B)
+	go func() {
+		defer close(reta)
+		defer close(retb)
+		for {
+			var p types.Pair[A, B]
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+				p = v
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case reta <- p.First:
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case retb <- p.Second:
+			}
+		}
+	}()
+	return reta, retb
+}
+
+// Enumerate pairs each value received from in with its index, counting from 0.
+func Enumerate[T any](ctx context.Context, in <-chan T) <-chan types.Pair[int, T] {
+	ret := make(chan types.Pair[int, T])
+	go func() {
+		defer close(ret)
+		for i := 0; ; i++ {
+			var v T
+			select {
+			case <-ctx.Done():
+				return
+			case vv, ok := <-in:
+				if !ok {
+					return
+				}
+				v = vv
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case ret <- types.NewPair(i, v):
+			}
+		}
+	}()
+	return ret
+}
@@ -0,0 +1,101 @@
+package channel_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Warashi/go-generics/channel"
+	"github.com/Warashi/go-generics/optional"
+	"github.com/Warashi/go-generics/types"
+)
+
+func of[T any](v ...T) <-chan T {
+	ch := make(chan T, len(v))
+	for _, v := range v {
+		ch <- v
+	}
+	close(ch)
+	return ch
+}
+
+func collect[T any](ch <-chan T) []T {
+	var v []T
+	for x := range ch {
+		v = append(v, x)
+	}
+	return v
+}
+
+func TestZip(t *testing.T) {
+	got := collect(channel.Zip(context.Background(), of(1, 2, 3), of("a", "b")))
+	if want := []types.Pair[int, string]{types.NewPair(1, "a"), types.NewPair(2, "b")}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	t.Run("context canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := channel.Zip(ctx, make(chan int), make(chan string))
+		cancel()
+		if v, ok := <-ch; ok {
+			t.Errorf("got %v, want closed channel", v)
+		}
+	})
+}
+
+func TestZipWith(t *testing.T) {
+	add := types.BiClosure[int, int, int](func(a, b int) int { return a + b })
+	got := collect(channel.ZipWith[int, int, int](context.Background(), of(1, 2), of(10, 20, 30), add))
+	if want := []int{11, 22}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestZipLongest(t *testing.T) {
+	got := collect(channel.ZipLongest(context.Background(), of(1, 2), of("a")))
+	want := []types.Pair[optional.Optional[int], optional.Optional[string]]{
+		types.NewPair(optional.New(1), optional.New("a")),
+		types.NewPair(optional.New(2), optional.Empty[string]()),
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	a, b := channel.Unzip(context.Background(), of(types.NewPair(1, "a"), types.NewPair(2, "b")))
+	var (
+		gota []int
+		gotb []string
+	)
+	for a != nil || b != nil {
+		select {
+		case v, ok := <-a:
+			if !ok {
+				a = nil
+				continue
+			}
+			gota = append(gota, v)
+		case v, ok := <-b:
+			if !ok {
+				b = nil
+				continue
+			}
+			gotb = append(gotb, v)
+		}
+	}
+	if want := []int{1, 2}; !cmp.Equal(gota, want) {
+		t.Errorf("first = %v, want %v", gota, want)
+	}
+	if want := []string{"a", "b"}; !cmp.Equal(gotb, want) {
+		t.Errorf("second = %v, want %v", gotb, want)
+	}
+}
+
+func TestEnumerate(t *testing.T) {
+	got := collect(channel.Enumerate(context.Background(), of("a", "b")))
+	if want := []types.Pair[int, string]{types.NewPair(0, "a"), types.NewPair(1, "b")}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
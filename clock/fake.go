@@ -0,0 +1,182 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+var (
+	_ Timer  = (*fakeTimer)(nil)
+	_ Ticker = fakeTicker{}
+)
+
+// Fake is a Clock whose time only moves when Advance is called.
+// Like the time package, it drops ticks that the receiver is not ready for.
+type Fake struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	now    time.Time
+	timers map[*fakeTimer]struct{}
+}
+
+// NewFake returns a Fake whose current time is now.
+func NewFake(now time.Time) *Fake {
+	f := &Fake{now: now, timers: make(map[*fakeTimer]struct{})}
+	f.cond = sync.NewCond(&f.mu)
+	return f
+}
+
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+func (f *Fake) NewTimer(d time.Duration) Timer {
+	return f.newTimer(d, 0)
+}
+
+func (f *Fake) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("non-positive interval for clock.Fake.NewTicker")
+	}
+	return fakeTicker{f.newTimer(d, d)}
+}
+
+func (f *Fake) newTimer(d, period time.Duration) *fakeTimer {
+	t := &fakeTimer{clock: f, c: make(chan time.Time, 1), period: period}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.schedule(t, d)
+	return t
+}
+
+// schedule activates t to fire after d. f.mu must be held.
+func (f *Fake) schedule(t *fakeTimer, d time.Duration) {
+	t.when = f.now.Add(d)
+	f.timers[t] = struct{}{}
+	f.cond.Broadcast()
+	if d <= 0 {
+		f.fire(t)
+	}
+}
+
+// fire sends the current time to t and reschedules or deactivates it. f.mu must be held.
+func (f *Fake) fire(t *fakeTimer) {
+	select {
+	case t.c <- f.now:
+	default:
+	}
+	defer f.cond.Broadcast()
+	if t.period > 0 {
+		t.when = t.when.Add(t.period)
+		return
+	}
+	delete(f.timers, t)
+}
+
+// Advance moves the time forward by d, firing the timers and tickers that expire on the way in chronological order.
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	target := f.now.Add(d)
+	for {
+		var next *fakeTimer
+		for t := range f.timers {
+			if !t.when.After(target) && (next == nil || t.when.Before(next.when)) {
+				next = t
+			}
+		}
+		if next == nil {
+			break
+		}
+		f.now = next.when
+		f.fire(next)
+	}
+	f.now = target
+}
+
+// BlockUntil waits until at least n timers and tickers are active.
+// It lets a test wait for the code under test to start waiting before calling Advance.
+func (f *Fake) BlockUntil(n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for len(f.timers) < n {
+		f.cond.Wait()
+	}
+}
+
+// BlockUntilScheduled waits until a timer or ticker is set to fire at t.
+// It lets a test wait for the code under test to reset a timer, which BlockUntil cannot observe.
+func (f *Fake) BlockUntilScheduled(t time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for !f.scheduled(t) {
+		f.cond.Wait()
+	}
+}
+
+// scheduled reports whether a timer or ticker is set to fire at t. f.mu must be held.
+func (f *Fake) scheduled(t time.Time) bool {
+	for timer := range f.timers {
+		if timer.when.Equal(t) {
+			return true
+		}
+	}
+	return false
+}
+
+type fakeTimer struct {
+	clock  *Fake
+	c      chan time.Time
+	when   time.Time
+	period time.Duration
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.c
+}
+
+// stop deactivates t and discards a pending tick, as time.Timer does since Go 1.23. It reports whether t was active.
+func (t *fakeTimer) stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	_, active := t.clock.timers[t]
+	delete(t.clock.timers, t)
+	t.clock.cond.Broadcast()
+	select {
+	case <-t.c:
+	default:
+	}
+	return active
+}
+
+func (t *fakeTimer) Stop() bool {
+	return t.stop()
+}
+
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	active := t.stop()
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	if t.period > 0 {
+		t.period = d
+	}
+	t.clock.schedule(t, d)
+	return active
+}
+
+type fakeTicker struct {
+	*fakeTimer
+}
+
+func (t fakeTicker) Stop() {
+	t.stop()
+}
+
+func (t fakeTicker) Reset(d time.Duration) {
+	if d <= 0 {
+		panic("non-positive interval for clock.Fake ticker Reset")
+	}
+	t.fakeTimer.Reset(d)
+}
@@ -0,0 +1,116 @@
+package clock_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Warashi/go-generics/clock"
+)
+
+var epoch = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func received(c <-chan time.Time) (time.Time, bool) {
+	select {
+	case v := <-c:
+		return v, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+func TestFake_Timer(t *testing.T) {
+	c := clock.NewFake(epoch)
+	timer := c.NewTimer(time.Second)
+
+	c.Advance(999 * time.Millisecond)
+	if _, ok := received(timer.C()); ok {
+		t.Fatalf("timer fired early")
+	}
+	c.Advance(time.Millisecond)
+	if got, ok := received(timer.C()); !ok || !got.Equal(epoch.Add(time.Second)) {
+		t.Fatalf("got %v and %t, want %v and %t", got, ok, epoch.Add(time.Second), true)
+	}
+	if timer.Stop() {
+		t.Errorf("Stop() of a fired timer = true, want false")
+	}
+
+	if timer.Reset(time.Second) {
+		t.Errorf("Reset() of a fired timer = true, want false")
+	}
+	if !timer.Stop() {
+		t.Errorf("Stop() of an active timer = false, want true")
+	}
+	c.Advance(time.Hour)
+	if _, ok := received(timer.C()); ok {
+		t.Errorf("stopped timer fired")
+	}
+	if got, want := c.Now(), epoch.Add(time.Hour+time.Second); !got.Equal(want) {
+		t.Errorf("Now() = %v, want %v", got, want)
+	}
+}
+
+func TestFake_Ticker(t *testing.T) {
+	c := clock.NewFake(epoch)
+	ticker := c.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for i := 1; i <= 3; i++ {
+		c.Advance(time.Second)
+		if got, ok := received(ticker.C()); !ok || !got.Equal(epoch.Add(time.Duration(i)*time.Second)) {
+			t.Fatalf("tick %d: got %v and %t, want %v and %t", i, got, ok, epoch.Add(time.Duration(i)*time.Second), true)
+		}
+	}
+
+	// ticks the receiver is not ready for are dropped.
+	c.Advance(3 * time.Second)
+	if got, ok := received(ticker.C()); !ok || !got.Equal(epoch.Add(4*time.Second)) {
+		t.Errorf("got %v and %t, want %v and %t", got, ok, epoch.Add(4*time.Second), true)
+	}
+	if _, ok := received(ticker.C()); ok {
+		t.Errorf("dropped tick was delivered")
+	}
+
+	ticker.Reset(2 * time.Second)
+	c.Advance(time.Second)
+	if _, ok := received(ticker.C()); ok {
+		t.Errorf("ticker fired before the new interval")
+	}
+	c.Advance(time.Second)
+	if _, ok := received(ticker.C()); !ok {
+		t.Errorf("ticker did not fire after the new interval")
+	}
+}
+
+func TestFake_BlockUntil(t *testing.T) {
+	c := clock.NewFake(epoch)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		<-c.NewTimer(time.Second).C()
+	}()
+	c.BlockUntil(1)
+	c.Advance(time.Second)
+	<-done
+}
+
+func TestReal(t *testing.T) {
+	var c clock.Clock = clock.Real{}
+	timer := c.NewTimer(time.Millisecond)
+	if got := <-timer.C(); got.IsZero() {
+		t.Errorf("got zero time")
+	}
+	ticker := c.NewTicker(time.Millisecond)
+	defer ticker.Stop()
+	<-ticker.C()
+}
+
+func TestFake_BlockUntilScheduled(t *testing.T) {
+	c := clock.NewFake(epoch)
+	timer := c.NewTimer(time.Second)
+	go timer.Reset(2 * time.Second)
+	c.BlockUntilScheduled(epoch.Add(2 * time.Second))
+	c.Advance(time.Second)
+	if _, ok := received(timer.C()); ok {
+		t.Errorf("timer fired at the deadline before Reset")
+	}
+}
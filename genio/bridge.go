@@ -0,0 +1,99 @@
+package genio
+
+import (
+	"context"
+	"io"
+)
+
+// SequenceReader implements the Reader interface by pulling elements from a
+// sequence with the Next/Value protocol of sequence.Sequence.
+type SequenceReader[T any] struct {
+	s interface {
+		Next() bool
+		Value() T
+	}
+}
+
+// Read implements the Reader interface.
+// It returns io.EOF when the sequence ends cleanly, or the error reported by
+// the Err method of the sequence, if it has one.
+func (r *SequenceReader[T]) Read(p []T) (n int, err error) {
+	for n < len(p) {
+		if !r.s.Next() {
+			return n, r.err()
+		}
+		p[n] = r.s.Value()
+		n++
+	}
+	return n, nil
+}
+
+func (r *SequenceReader[T]) err() error {
+	if e, ok := r.s.(interface{ Err() error }); ok {
+		if err := e.Err(); err != nil {
+			return err
+		}
+	}
+	return io.EOF
+}
+
+// Close closes the underlying sequence if it implements io.Closer.
+func (r *SequenceReader[T]) Close() error {
+	if c, ok := r.s.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// ReaderFromSequence returns a new SequenceReader reading from s.
+// s is typically a sequence.Sequence.
+func ReaderFromSequence[T any](s interface {
+	Next() bool
+	Value() T
+}) *SequenceReader[T] {
+	return &SequenceReader[T]{s: s}
+}
+
+// ChannelReader implements the Reader interface by receiving elements from a channel.
+type ChannelReader[T any] struct {
+	ctx context.Context
+	ch  <-chan T
+}
+
+// Read implements the Reader interface.
+// It blocks until at least one element is available, then returns the elements
+// that can be received without blocking, up to len(p).
+// It returns io.EOF once the channel is closed and ctx.Err() once ctx is done.
+func (r *ChannelReader[T]) Read(p []T) (n int, err error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	select {
+	case <-r.ctx.Done():
+		return 0, r.ctx.Err()
+	case v, ok := <-r.ch:
+		if !ok {
+			return 0, io.EOF
+		}
+		p[n] = v
+		n++
+	}
+	for n < len(p) {
+		select {
+		case v, ok := <-r.ch:
+			if !ok {
+				return n, io.EOF
+			}
+			p[n] = v
+			n++
+		default:
+			return n, nil
+		}
+	}
+	return n, nil
+}
+
+// ReaderFromChannel returns a new ChannelReader receiving from ch until it is closed or ctx is done.
+func ReaderFromChannel[T any](ctx context.Context, ch <-chan T) *ChannelReader[T] {
+	return &ChannelReader[T]{ctx: ctx, ch: ch}
+}
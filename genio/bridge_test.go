@@ -0,0 +1,35 @@
+package genio_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Warashi/go-generics/genio"
+)
+
+func TestReaderFromChannel(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 0
+	ch <- 1
+	ch <- 2
+	close(ch)
+
+	result, err := genio.ReadAll[int](genio.ReaderFromChannel(context.Background(), ch))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != 3 || result[0] != 0 || result[2] != 2 {
+		t.Fatalf("invalid result %v", result)
+	}
+}
+
+func TestReaderFromChannelCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := genio.ReaderFromChannel(ctx, make(chan int)).Read(make([]int, 1))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want %v", err, context.Canceled)
+	}
+}
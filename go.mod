@@ -1,6 +1,6 @@
 module github.com/Warashi/go-generics
 
-go 1.21.0
+go 1.23.0
 
 require (
 	github.com/google/go-cmp v0.6.0
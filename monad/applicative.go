@@ -0,0 +1,23 @@
+package monad
+
+import "github.com/Warashi/go-generics/types"
+
+// Applicative applies functions wrapped in MF to values wrapped in MT.
+// MF is the container of types.Function[T, U], e.g. []types.Function[T, U] for slices.
+type Applicative[T, U, MT, MU, MF any] interface {
+	Unitter[U, MU]
+	Ap(fs MF, src MT) MU
+}
+
+func Ap[MU, T, U, MT, MF any, Impl Applicative[T, U, MT, MU, MF]](impl Impl, fs MF, src MT) MU {
+	return impl.Ap(fs, src)
+}
+
+// Map2 lifts the binary function f over a and b, also known as LiftA2.
+// implA partially applies f to each value of a and implB applies the resulting functions to b.
+func Map2[MC, A, B, C, MA, MB, MF any, ImplA Monad[A, types.Function[B, C], MA, MF], ImplB Applicative[B, C, MB, MC, MF]](implA ImplA, implB ImplB, a MA, b MB, f types.BiFunction[A, B, C]) MC {
+	fs := Map[MF](implA, a, types.Closure[A, types.Function[B, C]](func(x A) types.Function[B, C] {
+		return types.Closure[B, C](func(y B) C { return f.Apply(x, y) })
+	}))
+	return implB.Ap(fs, b)
+}
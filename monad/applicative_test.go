@@ -0,0 +1,81 @@
+package monad_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Warashi/go-generics/channel"
+	"github.com/Warashi/go-generics/optional"
+	"github.com/Warashi/go-generics/sequence"
+	"github.com/Warashi/go-generics/slice"
+	"github.com/Warashi/go-generics/types"
+)
+
+func TestAp(t *testing.T) {
+	inc := types.Function[int, int](types.Closure[int, int](func(v int) int { return v + 1 }))
+	double := types.Function[int, int](types.Closure[int, int](func(v int) int { return v * 2 }))
+
+	t.Run("slice", func(t *testing.T) {
+		got := slice.Ap([]types.Function[int, int]{inc, double}, []int{1, 2})
+		if want := []int{2, 3, 2, 4}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v, diff %v", got, want, cmp.Diff(got, want))
+		}
+	})
+	t.Run("sequence", func(t *testing.T) {
+		got := sequence.Collect(sequence.Ap(sequence.Of(inc, double), sequence.Of(1, 2)))
+		if want := []int{2, 3, 2, 4}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v, diff %v", got, want, cmp.Diff(got, want))
+		}
+	})
+	t.Run("option", func(t *testing.T) {
+		if got, want := optional.Ap(optional.New(inc), optional.New(1)), optional.New(2); !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+		if got, want := optional.Ap(optional.Empty[types.Function[int, int]](), optional.New(1)), optional.Empty[int](); !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+}
+
+func TestMap2(t *testing.T) {
+	join := types.BiClosure[int, string, string](func(a int, b string) string { return strconv.Itoa(a) + b })
+
+	t.Run("slice", func(t *testing.T) {
+		got := slice.Map2[int, string, string]([]int{1, 2}, []string{"a", "b"}, join)
+		if want := []string{"1a", "1b", "2a", "2b"}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v, diff %v", got, want, cmp.Diff(got, want))
+		}
+	})
+	t.Run("sequence", func(t *testing.T) {
+		got := sequence.Collect(sequence.Map2[int, string, string](sequence.Of(1, 2), sequence.Of("a", "b"), join))
+		if want := []string{"1a", "1b", "2a", "2b"}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v, diff %v", got, want, cmp.Diff(got, want))
+		}
+	})
+	t.Run("option", func(t *testing.T) {
+		if got, want := optional.Map2[int, string, string](optional.New(1), optional.New("a"), join), optional.New("1a"); !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+		if got, want := optional.Map2[int, string, string](optional.New(1), optional.Empty[string](), join), optional.Empty[string](); !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+	t.Run("channel", func(t *testing.T) {
+		a, b := make(chan int, 2), make(chan string, 2)
+		a <- 1
+		a <- 2
+		b <- "a"
+		b <- "b"
+		close(a)
+		close(b)
+		var got []string
+		for v := range channel.Map2[int, string, string](a, b, join) {
+			got = append(got, v)
+		}
+		if want := []string{"1a", "1b", "2a", "2b"}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v, diff %v", got, want, cmp.Diff(got, want))
+		}
+	})
+}
@@ -0,0 +1,40 @@
+package monad
+
+import (
+	"golang.org/x/exp/constraints"
+
+	"github.com/Warashi/go-generics/types"
+)
+
+type Number interface {
+	constraints.Integer | constraints.Float | constraints.Complex
+}
+
+type Foldable[T, A, MT any] interface {
+	FoldLeft(m MT, init A, f types.BiFunction[A, T, A]) A
+	FoldRight(m MT, init A, f types.BiFunction[T, A, A]) A
+}
+
+func Reduce[T, A, MT any, Impl Foldable[T, A, MT]](impl Impl, src MT, init A, f types.BiFunction[A, T, A]) A {
+	return impl.FoldLeft(src, init, f)
+}
+
+func Sum[T Number, MT any, Impl Foldable[T, T, MT]](impl Impl, src MT) T {
+	return impl.FoldLeft(src, 0, types.BiClosure[T, T, T](func(acc, value T) T { return acc + value }))
+}
+
+func Count[T, MT any, Impl Foldable[T, int, MT]](impl Impl, src MT) int {
+	return impl.FoldLeft(src, 0, types.BiClosure[int, T, int](func(acc int, _ T) int { return acc + 1 }))
+}
+
+// Any reports whether f returns true for some element of src.
+// Since folding cannot stop early, every element of src is consumed.
+func Any[T, MT any, Impl Foldable[T, bool, MT]](impl Impl, src MT, f types.Function[T, bool]) bool {
+	return impl.FoldLeft(src, false, types.BiClosure[bool, T, bool](func(acc bool, value T) bool { return acc || f.Apply(value) }))
+}
+
+// All reports whether f returns true for every element of src.
+// Since folding cannot stop early, every element of src is consumed.
+func All[T, MT any, Impl Foldable[T, bool, MT]](impl Impl, src MT, f types.Function[T, bool]) bool {
+	return impl.FoldLeft(src, true, types.BiClosure[bool, T, bool](func(acc bool, value T) bool { return acc && f.Apply(value) }))
+}
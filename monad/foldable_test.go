@@ -0,0 +1,96 @@
+package monad_test
+
+import (
+	"testing"
+
+	"github.com/Warashi/go-generics/channel"
+	"github.com/Warashi/go-generics/monad"
+	"github.com/Warashi/go-generics/optional"
+	"github.com/Warashi/go-generics/sequence"
+	"github.com/Warashi/go-generics/slice"
+	"github.com/Warashi/go-generics/types"
+)
+
+func TestReduce(t *testing.T) {
+	concat := types.BiClosure[string, string, string](func(acc, v string) string { return acc + v })
+
+	t.Run("slice", func(t *testing.T) {
+		if got, want := monad.Reduce[string](slice.MonadImpl[string, string]{}, []string{"a", "b", "c"}, "", concat), "abc"; got != want {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+	t.Run("sequence", func(t *testing.T) {
+		if got, want := monad.Reduce[string](sequence.MonadImpl[string, string]{}, sequence.Of("a", "b", "c"), "", concat), "abc"; got != want {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+	t.Run("option", func(t *testing.T) {
+		if got, want := monad.Reduce[string](optional.MonadImpl[string, string]{}, optional.New("a"), "_", concat), "_a"; got != want {
+			t.Errorf("got %v, want %v", got, want)
+		}
+		if got, want := monad.Reduce[string](optional.MonadImpl[string, string]{}, optional.Empty[string](), "_", concat), "_"; got != want {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+	t.Run("channel", func(t *testing.T) {
+		ch := make(chan string, 3)
+		ch <- "a"
+		ch <- "b"
+		ch <- "c"
+		close(ch)
+		if got, want := monad.Reduce[string](channel.MonadImpl[string, string]{}, (<-chan string)(ch), "", concat), "abc"; got != want {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+}
+
+func TestFoldRight(t *testing.T) {
+	concat := types.BiClosure[string, string, string](func(v, acc string) string { return acc + v })
+
+	if got, want := slice.FoldRight([]string{"a", "b", "c"}, "", concat), "cba"; got != want {
+		t.Errorf("slice: got %v, want %v", got, want)
+	}
+	if got, want := sequence.FoldRight(sequence.Of("a", "b", "c"), "", concat), "cba"; got != want {
+		t.Errorf("sequence: got %v, want %v", got, want)
+	}
+}
+
+func TestSumCount(t *testing.T) {
+	s := []int{1, 2, 3, 4}
+	if got, want := monad.Sum(slice.MonadImpl[int, int]{}, s), 10; got != want {
+		t.Errorf("Sum() = %v, want %v", got, want)
+	}
+	if got, want := monad.Count(slice.MonadImpl[int, int]{}, s), 4; got != want {
+		t.Errorf("Count() = %v, want %v", got, want)
+	}
+	if got, want := monad.Count(sequence.MonadImpl[int, int]{}, sequence.Of[int]()), 0; got != want {
+		t.Errorf("Count() = %v, want %v", got, want)
+	}
+}
+
+func TestAnyAll(t *testing.T) {
+	odd := types.Closure[int, bool](func(v int) bool { return v%2 != 0 })
+	impl := slice.MonadImpl[int, bool]{}
+
+	tests := []struct {
+		name    string
+		in      []int
+		wantAny bool
+		wantAll bool
+	}{
+		{name: "empty", in: nil, wantAny: false, wantAll: true},
+		{name: "all odd", in: []int{1, 3}, wantAny: true, wantAll: true},
+		{name: "some odd", in: []int{1, 2}, wantAny: true, wantAll: false},
+		{name: "no odd", in: []int{2, 4}, wantAny: false, wantAll: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := monad.Any(impl, tt.in, odd); got != tt.wantAny {
+				t.Errorf("Any() = %v, want %v", got, tt.wantAny)
+			}
+			if got := monad.All(impl, tt.in, odd); got != tt.wantAll {
+				t.Errorf("All() = %v, want %v", got, tt.wantAll)
+			}
+		})
+	}
+}
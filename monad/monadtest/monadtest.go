@@ -0,0 +1,144 @@
+// Package monadtest checks that an implementation of monad.Monad obeys the monad laws.
+//
+// Every law is checked as a property test: the generators are called with a *rand.Rand
+// seeded identically for both sides of an equation, so lazily evaluated or single-pass
+// monads such as sequences and channels can be compared by consuming them in Equal.
+package monadtest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/Warashi/go-generics/monad"
+	"github.com/Warashi/go-generics/types"
+)
+
+// Generator produces random inputs for the laws.
+// Each function must be deterministic for a given *rand.Rand state and return a fresh MT on every call.
+type Generator[T, MT any] struct {
+	Value    func(r *rand.Rand) T
+	Monad    func(r *rand.Rand) MT
+	Function func(r *rand.Rand) types.Function[T, MT]
+}
+
+type config struct {
+	iterations int
+	seed       int64
+}
+
+type Option func(c *config)
+
+// WithIterations sets how many random cases are checked for each law. The default is 100.
+func WithIterations(n int) Option {
+	return func(c *config) {
+		c.iterations = n
+	}
+}
+
+// WithSeed sets the seed of the first case. Case i uses seed+i.
+func WithSeed(seed int64) Option {
+	return func(c *config) {
+		c.seed = seed
+	}
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{iterations: 100, seed: 1}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// law checks lhs(r) == rhs(r) for every case, where both sides get a *rand.Rand with the same seed.
+// It reports the first failing case of the law and skips the rest of them.
+func law[MT any](t testing.TB, c *config, name string, equal func(MT, MT) bool, lhs, rhs func(r *rand.Rand) MT) {
+	t.Helper()
+	for i := 0; i < c.iterations; i++ {
+		seed := c.seed + int64(i)
+		l, r := lhs(rand.New(rand.NewSource(seed))), rhs(rand.New(rand.NewSource(seed)))
+		if !equal(l, r) {
+			t.Errorf("%s does not hold, seed = %d", name, seed)
+			return
+		}
+	}
+}
+
+// TestMonad checks left identity, right identity and associativity of impl.
+func TestMonad[T, MT any, Impl monad.Monad[T, T, MT, MT]](t testing.TB, impl Impl, gen Generator[T, MT], equal func(MT, MT) bool, opts ...Option) {
+	t.Helper()
+	c := newConfig(opts)
+	unit := types.Closure[T, MT](impl.Unit)
+
+	law(t, c, "left identity", equal,
+		func(r *rand.Rand) MT {
+			a, f := gen.Value(r), gen.Function(r)
+			return impl.Bind(impl.Unit(a), f)
+		},
+		func(r *rand.Rand) MT {
+			a, f := gen.Value(r), gen.Function(r)
+			return f.Apply(a)
+		},
+	)
+	law(t, c, "right identity", equal,
+		func(r *rand.Rand) MT {
+			return impl.Bind(gen.Monad(r), unit)
+		},
+		func(r *rand.Rand) MT {
+			return gen.Monad(r)
+		},
+	)
+	law(t, c, "associativity", equal,
+		func(r *rand.Rand) MT {
+			m, f, g := gen.Monad(r), gen.Function(r), gen.Function(r)
+			return impl.Bind(impl.Bind(m, f), g)
+		},
+		func(r *rand.Rand) MT {
+			m, f, g := gen.Monad(r), gen.Function(r), gen.Function(r)
+			return impl.Bind(m, types.Closure[T, MT](func(x T) MT { return impl.Bind(f.Apply(x), g) }))
+		},
+	)
+}
+
+// TestAdditiveMonad checks the laws of TestMonad and that Zero is the identity of Plus and a left zero of Bind,
+// and that Plus is associative.
+func TestAdditiveMonad[T, MT any, Impl monad.AdditiveMonad[T, T, MT, MT]](t testing.TB, impl Impl, gen Generator[T, MT], equal func(MT, MT) bool, opts ...Option) {
+	t.Helper()
+	TestMonad[T, MT](t, impl, gen, equal, opts...)
+	c := newConfig(opts)
+
+	law(t, c, "left zero", equal,
+		func(r *rand.Rand) MT {
+			return impl.Bind(impl.Zero(), gen.Function(r))
+		},
+		func(r *rand.Rand) MT {
+			return impl.Zero()
+		},
+	)
+	law(t, c, "plus left identity", equal,
+		func(r *rand.Rand) MT {
+			return impl.Plus(impl.Zero(), gen.Monad(r))
+		},
+		func(r *rand.Rand) MT {
+			return gen.Monad(r)
+		},
+	)
+	law(t, c, "plus right identity", equal,
+		func(r *rand.Rand) MT {
+			return impl.Plus(gen.Monad(r), impl.Zero())
+		},
+		func(r *rand.Rand) MT {
+			return gen.Monad(r)
+		},
+	)
+	law(t, c, "plus associativity", equal,
+		func(r *rand.Rand) MT {
+			x, y, z := gen.Monad(r), gen.Monad(r), gen.Monad(r)
+			return impl.Plus(impl.Plus(x, y), z)
+		},
+		func(r *rand.Rand) MT {
+			x, y, z := gen.Monad(r), gen.Monad(r), gen.Monad(r)
+			return impl.Plus(x, impl.Plus(y, z))
+		},
+	)
+}
@@ -0,0 +1,103 @@
+package monadtest_test
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/Warashi/go-generics/monad/monadtest"
+	"github.com/Warashi/go-generics/slice"
+	"github.com/Warashi/go-generics/types"
+)
+
+// brokenUnit duplicates the value in Unit, which breaks both identity laws.
+type brokenUnit struct {
+	slice.MonadImpl[int, int]
+}
+
+func (brokenUnit) Unit(v int) []int {
+	return []int{v, v}
+}
+
+// brokenZero is not empty, which breaks the identity laws of Plus.
+type brokenZero struct {
+	slice.MonadImpl[int, int]
+}
+
+func (brokenZero) Zero() []int {
+	return []int{0}
+}
+
+var (
+	gen = monadtest.Generator[int, []int]{
+		Value: func(r *rand.Rand) int { return r.Intn(10) },
+		Monad: func(r *rand.Rand) []int {
+			v := make([]int, r.Intn(4))
+			for i := range v {
+				v[i] = r.Intn(10)
+			}
+			return v
+		},
+		Function: func(r *rand.Rand) types.Function[int, []int] {
+			k := r.Intn(5)
+			return types.Closure[int, []int](func(x int) []int { return []int{x + k} })
+		},
+	}
+	equal = func(a, b []int) bool { return cmp.Equal(a, b, cmpopts.EquateEmpty()) }
+)
+
+// fakeTB records the failures reported to it instead of failing the test.
+type fakeTB struct {
+	testing.TB
+	errors []string
+}
+
+func (*fakeTB) Helper() {}
+
+func (tb *fakeTB) Errorf(format string, args ...any) {
+	tb.errors = append(tb.errors, fmt.Sprintf(format, args...))
+}
+
+func (tb *fakeTB) Failed() bool {
+	return len(tb.errors) > 0
+}
+
+func TestDetectsViolation(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(tb testing.TB)
+		law  string
+	}{
+		{
+			name: "broken Unit",
+			run:  func(tb testing.TB) { monadtest.TestMonad[int, []int](tb, brokenUnit{}, gen, equal) },
+			law:  "left identity does not hold",
+		},
+		{
+			name: "broken Zero",
+			run:  func(tb testing.TB) { monadtest.TestAdditiveMonad[int, []int](tb, brokenZero{}, gen, equal) },
+			law:  "plus left identity does not hold",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tb := &fakeTB{TB: t}
+			tt.run(tb)
+			if !tb.Failed() {
+				t.Fatalf("the broken instance passed the laws")
+			}
+			if !slices.ContainsFunc(tb.errors, func(e string) bool { return strings.HasPrefix(e, tt.law) }) {
+				t.Errorf("errors %q do not report %q", tb.errors, tt.law)
+			}
+		})
+	}
+}
+
+func TestLawfulInstance(t *testing.T) {
+	monadtest.TestAdditiveMonad[int, []int](t, slice.MonadImpl[int, int]{}, gen, equal)
+}
@@ -0,0 +1,25 @@
+package monad
+
+import "github.com/Warashi/go-generics/types"
+
+// Traverse applies f to each element of src and collects the results into a single MS, i.e. M[[]U].
+// implS works on the accumulated M[[]U] and implU appends each U produced by f to it.
+// For additive monads such as optional, a single Zero makes the whole result Zero.
+func Traverse[MS, T, U, MU any, ImplS Monad[[]U, []U, MS, MS], ImplU Monad[U, []U, MU, MS]](implS ImplS, implU ImplU, src []T, f types.Function[T, MU]) MS {
+	acc := implS.Unit(make([]U, 0, len(src)))
+	for _, v := range src {
+		v := v
+		acc = implS.Bind(acc, types.Closure[[]U, MS](func(us []U) MS {
+			return implU.Bind(f.Apply(v), types.Closure[U, MS](func(u U) MS {
+				// the full slice expression forces a copy so that branches of non-deterministic monads do not share a backing array.
+				return implS.Unit(append(us[:len(us):len(us)], u))
+			}))
+		}))
+	}
+	return acc
+}
+
+// SequenceA turns a slice of M[T] into M[[]T]. It is Traverse with the identity function.
+func SequenceA[MS, T, MT any, ImplS Monad[[]T, []T, MS, MS], ImplT Monad[T, []T, MT, MS]](implS ImplS, implT ImplT, src []MT) MS {
+	return Traverse[MS, MT, T](implS, implT, src, types.Identity[MT]{})
+}
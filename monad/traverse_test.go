@@ -0,0 +1,99 @@
+package monad_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Warashi/go-generics/monad"
+	"github.com/Warashi/go-generics/optional"
+	"github.com/Warashi/go-generics/result"
+	"github.com/Warashi/go-generics/sequence"
+	"github.com/Warashi/go-generics/slice"
+	"github.com/Warashi/go-generics/types"
+)
+
+func TestTraverse(t *testing.T) {
+	t.Run("option", func(t *testing.T) {
+		atoi := types.Closure[string, optional.Optional[int]](func(s string) optional.Optional[int] {
+			v, err := strconv.Atoi(s)
+			if err != nil {
+				return optional.Empty[int]()
+			}
+			return optional.New(v)
+		})
+
+		tests := []struct {
+			name string
+			in   []string
+			want optional.Optional[[]int]
+		}{
+			{name: "all present", in: []string{"1", "2", "3"}, want: optional.New([]int{1, 2, 3})},
+			{name: "one empty", in: []string{"1", "x", "3"}, want: optional.Empty[[]int]()},
+			{name: "no input", in: nil, want: optional.New([]int{})},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if got := optional.Traverse[string, int](tt.in, atoi); !cmp.Equal(got, tt.want) {
+					t.Errorf("got %v, want %v", got, tt.want)
+				}
+			})
+		}
+	})
+	t.Run("result", func(t *testing.T) {
+		atoi := types.Closure[string, result.Result[int]](func(s string) result.Result[int] { return result.New(strconv.Atoi(s)) })
+		traverse := func(in []string) result.Result[[]int] {
+			return monad.Traverse[result.Result[[]int], string, int](result.MonadImpl[[]int, []int]{}, result.MonadImpl[int, []int]{}, in, atoi)
+		}
+
+		if got, want := traverse([]string{"1", "2"}), result.Ok([]int{1, 2}); !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+		var numErr *strconv.NumError
+		if got := traverse([]string{"1", "x"}); !errors.As(got.Err(), &numErr) {
+			t.Errorf("got %v, want *strconv.NumError", got.Err())
+		}
+	})
+	t.Run("slice", func(t *testing.T) {
+		pm := types.Closure[int, []int](func(v int) []int { return []int{v, -v} })
+		got := slice.Traverse[int, int]([]int{1, 2}, pm)
+		if want := [][]int{{1, 2}, {1, -2}, {-1, 2}, {-1, -2}}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v, diff %v", got, want, cmp.Diff(got, want))
+		}
+	})
+	t.Run("sequence", func(t *testing.T) {
+		pm := types.Closure[int, sequence.Sequence[int]](func(v int) sequence.Sequence[int] { return sequence.Of(v, -v) })
+		got := sequence.Collect(sequence.Traverse[int, int]([]int{1, 2}, pm))
+		if want := [][]int{{1, 2}, {1, -2}, {-1, 2}, {-1, -2}}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v, diff %v", got, want, cmp.Diff(got, want))
+		}
+	})
+}
+
+func TestSequenceA(t *testing.T) {
+	t.Run("option", func(t *testing.T) {
+		if got, want := optional.SequenceA([]optional.Optional[int]{optional.New(1), optional.New(2)}), optional.New([]int{1, 2}); !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+		if got, want := optional.SequenceA([]optional.Optional[int]{optional.New(1), optional.Empty[int]()}), optional.Empty[[]int](); !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+	t.Run("slice", func(t *testing.T) {
+		got := slice.SequenceA([][]int{{1, 2}, {3}})
+		if want := [][]int{{1, 3}, {2, 3}}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v, diff %v", got, want, cmp.Diff(got, want))
+		}
+		if got := slice.SequenceA([][]int{{1, 2}, {}}); len(got) != 0 {
+			t.Errorf("got %v, want empty", got)
+		}
+	})
+	t.Run("sequence", func(t *testing.T) {
+		got := sequence.Collect(sequence.SequenceA([]sequence.Sequence[int]{sequence.Of(1, 2), sequence.Of(3, 4)}))
+		if want := [][]int{{1, 3}, {1, 4}, {2, 3}, {2, 4}}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v, diff %v", got, want, cmp.Diff(got, want))
+		}
+	})
+}
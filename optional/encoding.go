@@ -0,0 +1,112 @@
+package optional
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+)
+
+var (
+	_ json.Marshaler           = Optional[int]{}
+	_ json.Unmarshaler         = (*Optional[int])(nil)
+	_ encoding.TextMarshaler   = Optional[int]{}
+	_ encoding.TextUnmarshaler = (*Optional[int])(nil)
+	_ sql.Scanner              = (*Optional[int])(nil)
+	_ driver.Valuer            = Optional[int]{}
+)
+
+// MarshalJSON encodes an empty Optional as null and a present one as its value.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if o.IsEmpty() {
+		return []byte("null"), nil
+	}
+	// marshal through the pointer so that a MarshalJSON method on *T is used.
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON decodes null as an empty Optional and anything else as a present value.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = Empty[T]()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = New(v)
+	return nil
+}
+
+// MarshalText encodes an empty Optional as empty text. A present value is encoded
+// with its own MarshalText if T or *T implements encoding.TextMarshaler, as is for strings and byte slices,
+// and as JSON otherwise, which UnmarshalText decodes back.
+//
+// A present value encoded as empty text, such as New(""), is decoded by UnmarshalText as an empty Optional,
+// so it does not survive a round trip through text. Use JSON to tell the two apart.
+func (o Optional[T]) MarshalText() ([]byte, error) {
+	if o.IsEmpty() {
+		return []byte{}, nil
+	}
+	switch v := any(o.value).(type) {
+	case encoding.TextMarshaler:
+		return v.MarshalText()
+	case *string:
+		return []byte(*v), nil
+	case *[]byte:
+		return bytes.Clone(*v), nil
+	default:
+		return json.Marshal(o.value)
+	}
+}
+
+// UnmarshalText decodes empty text as an empty Optional, even for types such as string
+// whose zero value MarshalText encodes as empty text. Other text is decoded
+// with UnmarshalText if *T implements encoding.TextUnmarshaler, as is for strings and byte slices,
+// and as a JSON literal otherwise, which covers numbers and booleans.
+func (o *Optional[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*o = Empty[T]()
+		return nil
+	}
+	var v T
+	switch p := any(&v).(type) {
+	case encoding.TextUnmarshaler:
+		if err := p.UnmarshalText(text); err != nil {
+			return err
+		}
+	case *string:
+		*p = string(text)
+	case *[]byte:
+		*p = bytes.Clone(text)
+	default:
+		if err := json.Unmarshal(text, p); err != nil {
+			return fmt.Errorf("optional.Optional.UnmarshalText: %w", err)
+		}
+	}
+	*o = New(v)
+	return nil
+}
+
+// Scan implements sql.Scanner. NULL is scanned as an empty Optional.
+// T should be one of the types accepted by sql.Null.
+func (o *Optional[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+	if !n.Valid {
+		*o = Empty[T]()
+		return nil
+	}
+	*o = New(n.V)
+	return nil
+}
+
+// Value implements driver.Valuer. An empty Optional is NULL.
+func (o Optional[T]) Value() (driver.Value, error) {
+	return sql.Null[T]{V: o.OrElseZero(), Valid: !o.IsEmpty()}.Value()
+}
@@ -0,0 +1,185 @@
+package optional_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Warashi/go-generics/jsonutil"
+	"github.com/Warashi/go-generics/optional"
+)
+
+// celsius has marshal methods on its pointer only.
+type celsius float64
+
+func (c *celsius) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fmt.Sprintf("%g°C", float64(*c)))
+}
+
+func (c *celsius) MarshalText() ([]byte, error) {
+	return fmt.Appendf(nil, "%g°C", float64(*c)), nil
+}
+
+func TestJSON(t *testing.T) {
+	type user struct {
+		Name string                    `json:"name"`
+		Age  optional.Optional[int]    `json:"age"`
+		Nick optional.Optional[string] `json:"nick"`
+	}
+	tests := []struct {
+		name string
+		in   user
+		json string
+	}{
+		{name: "present", in: user{Name: "a", Age: optional.New(0), Nick: optional.New("")}, json: `{"name":"a","age":0,"nick":""}`},
+		{name: "empty", in: user{Name: "b"}, json: `{"name":"b","age":null,"nick":null}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.in)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if got := string(b); got != tt.json {
+				t.Errorf("json.Marshal() = %s, want %s", got, tt.json)
+			}
+			got, err := jsonutil.Unmarshal[user](b)
+			if err != nil {
+				t.Fatalf("jsonutil.Unmarshal() error = %v", err)
+			}
+			if !cmp.Equal(got, tt.in) {
+				t.Errorf("round trip = %+v, want %+v", got, tt.in)
+			}
+		})
+	}
+
+	t.Run("pointer receiver", func(t *testing.T) {
+		b, err := json.Marshal(optional.New(celsius(21.5)))
+		if err != nil || string(b) != `"21.5°C"` {
+			t.Errorf("json.Marshal() = %s, %v, want %s", b, err, `"21.5°C"`)
+		}
+	})
+	t.Run("missing field", func(t *testing.T) {
+		got, err := jsonutil.Unmarshal[user]([]byte(`{"name":"c"}`))
+		if err != nil {
+			t.Fatalf("jsonutil.Unmarshal() error = %v", err)
+		}
+		if !got.Age.IsEmpty() {
+			t.Errorf("Age = %v, want empty", got.Age)
+		}
+	})
+	t.Run("invalid", func(t *testing.T) {
+		if _, err := jsonutil.Unmarshal[user]([]byte(`{"age":"x"}`)); err == nil {
+			t.Errorf("jsonutil.Unmarshal() error = %v, want error", err)
+		}
+	})
+}
+
+func TestText(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		b, err := optional.New(42).MarshalText()
+		if err != nil || string(b) != "42" {
+			t.Fatalf("MarshalText() = %s, %v, want %s", b, err, "42")
+		}
+		var o optional.Optional[int]
+		if err := o.UnmarshalText(b); err != nil || !cmp.Equal(o, optional.New(42)) {
+			t.Errorf("UnmarshalText() = %v, %v, want %v", o, err, 42)
+		}
+	})
+	t.Run("struct", func(t *testing.T) {
+		type point struct{ X, Y int }
+		want := optional.New(point{X: 1, Y: 2})
+		b, err := want.MarshalText()
+		if err != nil || string(b) != `{"X":1,"Y":2}` {
+			t.Fatalf("MarshalText() = %s, %v, want %s", b, err, `{"X":1,"Y":2}`)
+		}
+		var o optional.Optional[point]
+		if err := o.UnmarshalText(b); err != nil || !cmp.Equal(o, want) {
+			t.Errorf("UnmarshalText() = %v, %v, want %v", o, err, want)
+		}
+	})
+	t.Run("TextMarshaler", func(t *testing.T) {
+		addr := netip.MustParseAddr("192.0.2.1")
+		b, err := optional.New(addr).MarshalText()
+		if err != nil || string(b) != "192.0.2.1" {
+			t.Fatalf("MarshalText() = %s, %v, want %s", b, err, "192.0.2.1")
+		}
+		var o optional.Optional[netip.Addr]
+		if err := o.UnmarshalText(b); err != nil || o.OrElseZero() != addr {
+			t.Errorf("UnmarshalText() = %v, %v, want %v", o, err, addr)
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		b, err := optional.Empty[string]().MarshalText()
+		if err != nil || len(b) != 0 {
+			t.Fatalf("MarshalText() = %q, %v, want empty", b, err)
+		}
+		o := optional.New("x")
+		if err := o.UnmarshalText(b); err != nil || !o.IsEmpty() {
+			t.Errorf("UnmarshalText() = %v, %v, want empty", o, err)
+		}
+	})
+	t.Run("pointer receiver", func(t *testing.T) {
+		b, err := optional.New(celsius(21.5)).MarshalText()
+		if err != nil || string(b) != "21.5°C" {
+			t.Errorf("MarshalText() = %s, %v, want %s", b, err, "21.5°C")
+		}
+	})
+	t.Run("empty string", func(t *testing.T) {
+		// empty text cannot tell New("") from Empty, while JSON can.
+		b, err := optional.New("").MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText() error = %v", err)
+		}
+		var o optional.Optional[string]
+		if err := o.UnmarshalText(b); err != nil || !o.IsEmpty() {
+			t.Errorf("UnmarshalText() = %v, %v, want empty", o, err)
+		}
+		b, err = json.Marshal(optional.New(""))
+		if err != nil {
+			t.Fatalf("json.Marshal() error = %v", err)
+		}
+		got, err := jsonutil.Unmarshal[optional.Optional[string]](b)
+		if err != nil || !cmp.Equal(got, optional.New("")) {
+			t.Errorf("jsonutil.Unmarshal() = %v, %v, want %q", got, err, "")
+		}
+	})
+	t.Run("map key", func(t *testing.T) {
+		b, err := json.Marshal(map[optional.Optional[int]]string{optional.New(1): "a"})
+		if err != nil || string(b) != `{"1":"a"}` {
+			t.Errorf("json.Marshal() = %s, %v, want %s", b, err, `{"1":"a"}`)
+		}
+	})
+}
+
+func TestSQL(t *testing.T) {
+	t.Run("Scan", func(t *testing.T) {
+		var o optional.Optional[int64]
+		if err := o.Scan(int64(1)); err != nil || !cmp.Equal(o, optional.New(int64(1))) {
+			t.Errorf("Scan(1) = %v, %v, want %v", o, err, 1)
+		}
+		if err := o.Scan(nil); err != nil || !o.IsEmpty() {
+			t.Errorf("Scan(nil) = %v, %v, want empty", o, err)
+		}
+		var s optional.Optional[string]
+		if err := s.Scan([]byte("x")); err != nil || !cmp.Equal(s, optional.New("x")) {
+			t.Errorf("Scan([]byte) = %v, %v, want %v", s, err, "x")
+		}
+		var tm optional.Optional[time.Time]
+		if err := tm.Scan("not a time"); err == nil {
+			t.Errorf("Scan(string) into time.Time error = %v, want error", err)
+		}
+	})
+	t.Run("Value", func(t *testing.T) {
+		if v, err := optional.New("x").Value(); err != nil || v != "x" {
+			t.Errorf("Value() = %v, %v, want %v", v, err, "x")
+		}
+		if v, err := optional.Empty[string]().Value(); err != nil || v != nil {
+			t.Errorf("Value() = %v, %v, want nil", v, err)
+		}
+	})
+}
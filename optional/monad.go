@@ -5,6 +5,8 @@ import "github.com/Warashi/go-generics/types"
 
 var _ monad.Monad[int, string, Optional[int], Optional[string]] = MonadImpl[int, string]{}
 var _ monad.AdditiveMonad[int, string, Optional[int], Optional[string]] = MonadImpl[int, string]{}
+var _ monad.Foldable[int, string, Optional[int]] = MonadImpl[int, string]{}
+var _ monad.Applicative[int, string, Optional[int], Optional[string], Optional[types.Function[int, string]]] = MonadImpl[int, string]{}
 
 type MonadImpl[T, U any] struct{}
 
@@ -29,3 +31,24 @@ func (MonadImpl[T, U]) Plus(a Optional[T], b Optional[T]) Optional[T] {
 	}
 	return a
 }
+
+func (MonadImpl[T, U]) FoldLeft(src Optional[T], init U, f types.BiFunction[U, T, U]) U {
+	if src.IsEmpty() {
+		return init
+	}
+	return f.Apply(init, *src.value)
+}
+
+func (MonadImpl[T, U]) FoldRight(src Optional[T], init U, f types.BiFunction[T, U, U]) U {
+	if src.IsEmpty() {
+		return init
+	}
+	return f.Apply(*src.value, init)
+}
+
+func (MonadImpl[T, U]) Ap(f Optional[types.Function[T, U]], src Optional[T]) Optional[U] {
+	if f.IsEmpty() || src.IsEmpty() {
+		return Empty[U]()
+	}
+	return New((*f.value).Apply(*src.value))
+}
@@ -0,0 +1,36 @@
+package optional_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Warashi/go-generics/monad/monadtest"
+	"github.com/Warashi/go-generics/optional"
+	"github.com/Warashi/go-generics/types"
+)
+
+func TestMonadLaws(t *testing.T) {
+	gen := monadtest.Generator[int, optional.Optional[int]]{
+		Value: func(r *rand.Rand) int { return r.Intn(10) },
+		Monad: func(r *rand.Rand) optional.Optional[int] {
+			if r.Intn(4) == 0 {
+				return optional.Empty[int]()
+			}
+			return optional.New(r.Intn(10))
+		},
+		Function: func(r *rand.Rand) types.Function[int, optional.Optional[int]] {
+			k := r.Intn(5)
+			return types.Closure[int, optional.Optional[int]](func(x int) optional.Optional[int] {
+				if (x+k)%3 == 0 {
+					return optional.Empty[int]()
+				}
+				return optional.New(x + k)
+			})
+		},
+	}
+	equal := func(a, b optional.Optional[int]) bool { return cmp.Equal(a, b) }
+
+	monadtest.TestAdditiveMonad[int, optional.Optional[int]](t, optional.MonadImpl[int, int]{}, gen, equal)
+}
@@ -1,5 +1,7 @@
 package optional
 
+import "fmt"
+import "reflect"
 import "github.com/Warashi/go-generics/zero"
 import "github.com/Warashi/go-generics/types"
 import "github.com/Warashi/go-generics/monad"
@@ -24,6 +26,17 @@ func (o Optional[T]) IsEmpty() bool {
 	return o.value == nil
 }
 
+func (o Optional[T]) IsPresent() bool {
+	return o.value != nil
+}
+
+func (o Optional[T]) Get() (T, bool) {
+	if o.IsEmpty() {
+		return zero.New[T](), false
+	}
+	return *o.value, true
+}
+
 func (o Optional[T]) OrElse(e T) T {
 	if o.IsEmpty() {
 		return e
@@ -38,6 +51,57 @@ func (o Optional[T]) OrElseZero() T {
 	return *o.value
 }
 
+func (o Optional[T]) OrElseGet(f types.Supplier[T]) T {
+	if o.IsEmpty() {
+		return f.Get()
+	}
+	return *o.value
+}
+
+func (o Optional[T]) OrElseErr(err error) (T, error) {
+	if o.IsEmpty() {
+		return zero.New[T](), err
+	}
+	return *o.value, nil
+}
+
+func (o Optional[T]) Or(other Optional[T]) Optional[T] {
+	if o.IsEmpty() {
+		return other
+	}
+	return o
+}
+
+func (o Optional[T]) ToSlice() []T {
+	if o.IsEmpty() {
+		return nil
+	}
+	return []T{*o.value}
+}
+
+// ToPointer returns a pointer to a copy of the value, or nil if o is empty.
+func (o Optional[T]) ToPointer() *T {
+	if o.IsEmpty() {
+		return nil
+	}
+	v := *o.value
+	return &v
+}
+
+func (o Optional[T]) String() string {
+	if o.IsEmpty() {
+		return "<empty>"
+	}
+	return fmt.Sprint(*o.value)
+}
+
+func (o Optional[T]) GoString() string {
+	if o.IsEmpty() {
+		return fmt.Sprintf("optional.Empty[%v]()", reflect.TypeFor[T]())
+	}
+	return fmt.Sprintf("optional.New[%v](%#v)", reflect.TypeFor[T](), *o.value)
+}
+
 func New[T any](value T) Optional[T] {
 	return Optional[T]{value: &value}
 }
@@ -46,6 +110,29 @@ func Empty[T any]() Optional[T] {
 	return Optional[T]{}
 }
 
+// FromPointer returns an Optional holding a copy of *p, or an empty one if p is nil.
+func FromPointer[T any](p *T) Optional[T] {
+	if p == nil {
+		return Empty[T]()
+	}
+	return New(*p)
+}
+
+// FromZero returns an empty Optional if value is the zero value of T.
+func FromZero[T comparable](value T) Optional[T] {
+	if value == zero.New[T]() {
+		return Empty[T]()
+	}
+	return New(value)
+}
+
+func Zip[A, B any](a Optional[A], b Optional[B]) Optional[types.Pair[A, B]] {
+	if a.IsEmpty() || b.IsEmpty() {
+		return Empty[types.Pair[A, B]]()
+	}
+	return New(types.NewPair(*a.value, *b.value))
+}
+
 func Map[F, T any](o Optional[F], f types.Function[F, T]) Optional[T] {
 	return monad.Map[Optional[T]](MonadImpl[F, T]{}, o, f)
 }
@@ -61,3 +148,23 @@ func Filter[T any](from Optional[T], f types.Function[T, bool]) Optional[T] {
 func IfPresent[T any](from Optional[T], f types.Consumer[T]) {
 	monad.Do[types.Void, Optional[types.Void]](MonadImpl[T, types.Void]{}, from, f)
 }
+
+func Reduce[T, A any](from Optional[T], init A, f types.BiFunction[A, T, A]) A {
+	return monad.Reduce[T](MonadImpl[T, A]{}, from, init, f)
+}
+
+func Ap[F, T any](f Optional[types.Function[F, T]], from Optional[F]) Optional[T] {
+	return monad.Ap[Optional[T], F, T](MonadImpl[F, T]{}, f, from)
+}
+
+func Map2[A, B, C any](a Optional[A], b Optional[B], f types.BiFunction[A, B, C]) Optional[C] {
+	return monad.Map2[Optional[C]](MonadImpl[A, types.Function[B, C]]{}, MonadImpl[B, C]{}, a, b, f)
+}
+
+func Traverse[T, U any](from []T, f types.Function[T, Optional[U]]) Optional[[]U] {
+	return monad.Traverse[Optional[[]U], T, U](MonadImpl[[]U, []U]{}, MonadImpl[U, []U]{}, from, f)
+}
+
+func SequenceA[T any](from []Optional[T]) Optional[[]T] {
+	return monad.SequenceA[Optional[[]T], T](MonadImpl[[]T, []T]{}, MonadImpl[T, []T]{}, from)
+}
@@ -1,6 +1,8 @@
 package optional
 
 import (
+	"errors"
+	"fmt"
 	"strconv"
 	"testing"
 
@@ -72,3 +74,130 @@ func TestOptional_MapOrElseZero(t *testing.T) {
 	}
 
 }
+
+func TestOptional_Get(t *testing.T) {
+	if got, ok := New(0).Get(); !ok || got != 0 {
+		t.Errorf("Get() = %v, %v, want %v, %v", got, ok, 0, true)
+	}
+	if got, ok := Empty[int]().Get(); ok || got != 0 {
+		t.Errorf("Get() = %v, %v, want %v, %v", got, ok, 0, false)
+	}
+	if !New(0).IsPresent() || Empty[int]().IsPresent() {
+		t.Errorf("IsPresent() mismatch")
+	}
+}
+
+func TestOptional_OrElseGet(t *testing.T) {
+	called := 0
+	f := types.NewSupplier(func() int { called++; return 2 })
+	if got := New(1).OrElseGet(f); got != 1 || called != 0 {
+		t.Errorf("OrElseGet() = %v (called %d), want %v (called 0)", got, called, 1)
+	}
+	if got := Empty[int]().OrElseGet(f); got != 2 || called != 1 {
+		t.Errorf("OrElseGet() = %v (called %d), want %v (called 1)", got, called, 2)
+	}
+}
+
+func TestOptional_OrElseErr(t *testing.T) {
+	errEmpty := errors.New("empty")
+	if got, err := New(1).OrElseErr(errEmpty); got != 1 || err != nil {
+		t.Errorf("OrElseErr() = %v, %v, want %v, %v", got, err, 1, nil)
+	}
+	if got, err := Empty[int]().OrElseErr(errEmpty); got != 0 || !errors.Is(err, errEmpty) {
+		t.Errorf("OrElseErr() = %v, %v, want %v, %v", got, err, 0, errEmpty)
+	}
+}
+
+func TestOptional_Or(t *testing.T) {
+	tests := []struct {
+		name  string
+		o     Optional[int]
+		other Optional[int]
+		want  Optional[int]
+	}{
+		{name: "present", o: New(1), other: New(2), want: New(1)},
+		{name: "empty", o: Empty[int](), other: New(2), want: New(2)},
+		{name: "both empty", o: Empty[int](), other: Empty[int](), want: Empty[int]()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.Or(tt.other); !got.Equal(tt.want) {
+				t.Errorf("Or() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestZip(t *testing.T) {
+	if got, want := Zip(New(1), New("a")), New(types.NewPair(1, "a")); !got.Equal(want) {
+		t.Errorf("Zip() = %v, want %v", got, want)
+	}
+	if got := Zip(New(1), Empty[string]()); !got.IsEmpty() {
+		t.Errorf("Zip() = %v, want empty", got)
+	}
+	if got := Zip(Empty[int](), New("a")); !got.IsEmpty() {
+		t.Errorf("Zip() = %v, want empty", got)
+	}
+}
+
+func TestOptional_ToSlice(t *testing.T) {
+	if got := New(1).ToSlice(); len(got) != 1 || got[0] != 1 {
+		t.Errorf("ToSlice() = %v, want %v", got, []int{1})
+	}
+	if got := Empty[int]().ToSlice(); len(got) != 0 {
+		t.Errorf("ToSlice() = %v, want empty", got)
+	}
+}
+
+func TestPointer(t *testing.T) {
+	p := pointer.Of(1)
+	o := FromPointer(p)
+	*p = 2
+	if got := o.OrElseZero(); got != 1 {
+		t.Errorf("FromPointer() = %v, want %v", got, 1)
+	}
+	if got := FromPointer[int](nil); !got.IsEmpty() {
+		t.Errorf("FromPointer(nil) = %v, want empty", got)
+	}
+	q := o.ToPointer()
+	*q = 3
+	if got := o.OrElseZero(); got != 1 || *q != 3 {
+		t.Errorf("ToPointer() aliases the Optional: got %v", got)
+	}
+	if got := Empty[int]().ToPointer(); got != nil {
+		t.Errorf("ToPointer() = %v, want nil", got)
+	}
+}
+
+func TestFromZero(t *testing.T) {
+	if got := FromZero(0); !got.IsEmpty() {
+		t.Errorf("FromZero(0) = %v, want empty", got)
+	}
+	if got := FromZero(""); !got.IsEmpty() {
+		t.Errorf("FromZero(\"\") = %v, want empty", got)
+	}
+	if got := FromZero(1); !got.Equal(New(1)) {
+		t.Errorf("FromZero(1) = %v, want %v", got, 1)
+	}
+}
+
+func TestOptional_String(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		value  any
+		want   string
+	}{
+		{name: "String present", format: "%v", value: New(1), want: "1"},
+		{name: "String empty", format: "%v", value: Empty[int](), want: "<empty>"},
+		{name: "GoString present", format: "%#v", value: New("a"), want: `optional.New[string]("a")`},
+		{name: "GoString empty", format: "%#v", value: Empty[any](), want: "optional.Empty[interface {}]()"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.value); got != tt.want {
+				t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package result
+
+import (
+	"github.com/Warashi/go-generics/monad"
+	"github.com/Warashi/go-generics/types"
+)
+
+var (
+	_ monad.Monad[int, string, Result[int], Result[string]]         = MonadImpl[int, string]{}
+	_ monad.AdditiveMonad[int, string, Result[int], Result[string]] = MonadImpl[int, string]{}
+)
+
+type MonadImpl[T, U any] struct{}
+
+func (MonadImpl[T, U]) Unit(value U) Result[U] {
+	return Ok(value)
+}
+
+func (MonadImpl[T, U]) Bind(src Result[T], f types.Function[T, Result[U]]) Result[U] {
+	if src.IsErr() {
+		return Err[U](src.err)
+	}
+	return f.Apply(src.value)
+}
+
+func (MonadImpl[T, U]) Zero() Result[U] {
+	return Err[U](ErrEmpty)
+}
+
+// Plus returns the first Ok of a and b. If both are errors, it returns b unless b is the Zero, so that Zero is the identity of Plus.
+func (MonadImpl[T, U]) Plus(a, b Result[T]) Result[T] {
+	if a.IsOk() {
+		return a
+	}
+	if b.err == ErrEmpty {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,41 @@
+package result_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Warashi/go-generics/monad/monadtest"
+	"github.com/Warashi/go-generics/result"
+	"github.com/Warashi/go-generics/types"
+)
+
+func TestMonadLaws(t *testing.T) {
+	errOdd := errors.New("odd")
+	gen := monadtest.Generator[int, result.Result[int]]{
+		Value: func(r *rand.Rand) int { return r.Intn(10) },
+		Monad: func(r *rand.Rand) result.Result[int] {
+			switch r.Intn(4) {
+			case 0:
+				return result.Err[int](errTest)
+			case 1:
+				return result.MonadImpl[int, int]{}.Zero()
+			}
+			return result.Ok(r.Intn(10))
+		},
+		Function: func(r *rand.Rand) types.Function[int, result.Result[int]] {
+			k := r.Intn(5)
+			return types.Closure[int, result.Result[int]](func(x int) result.Result[int] {
+				if (x+k)%3 == 0 {
+					return result.Err[int](errOdd)
+				}
+				return result.Ok(x + k)
+			})
+		},
+	}
+	equal := func(a, b result.Result[int]) bool { return cmp.Equal(a, b) }
+
+	monadtest.TestAdditiveMonad[int, result.Result[int]](t, result.MonadImpl[int, int]{}, gen, equal)
+}
@@ -0,0 +1,139 @@
+package result
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Warashi/go-generics/future"
+	"github.com/Warashi/go-generics/monad"
+	"github.com/Warashi/go-generics/optional"
+	"github.com/Warashi/go-generics/types"
+	"github.com/Warashi/go-generics/zero"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// ErrEmpty is the error held by a Result produced by MonadImpl.Zero, e.g. when Filter rejects a value.
+var ErrEmpty = errors.New("result: empty")
+
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+func (r Result[T]) Equal(r2 Result[T]) bool {
+	if r.IsOk() && r2.IsOk() {
+		return cmp.Equal(r.value, r2.value, cmpopts.IgnoreUnexported())
+	}
+	if r.IsErr() && r2.IsErr() {
+		return r.err == r2.err
+	}
+	return false
+}
+
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+func (r Result[T]) Get() (T, error) {
+	if r.IsErr() {
+		return zero.New[T](), r.err
+	}
+	return r.value, nil
+}
+
+func (r Result[T]) OrElse(e T) T {
+	if r.IsErr() {
+		return e
+	}
+	return r.value
+}
+
+func (r Result[T]) OrElseZero() T {
+	if r.IsErr() {
+		return zero.New[T]()
+	}
+	return r.value
+}
+
+func Ok[T any](value T) Result[T] {
+	return Result[T]{value: value}
+}
+
+// Err returns a failed Result. A nil err is replaced with ErrEmpty so that the Result is never Ok.
+func Err[T any](err error) Result[T] {
+	if err == nil {
+		err = ErrEmpty
+	}
+	return Result[T]{err: err}
+}
+
+// New converts the (T, error) tuple style into a Result.
+// The value is discarded when err is not nil.
+func New[T any](value T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(value)
+}
+
+// FromOptional returns Ok with the value of o, or Err with err when o is empty.
+func FromOptional[T any](o optional.Optional[T], err error) Result[T] {
+	if o.IsEmpty() {
+		return Err[T](err)
+	}
+	return Ok(o.OrElseZero())
+}
+
+// ToOptional returns the value of r as an Optional, dropping the error.
+func ToOptional[T any](r Result[T]) optional.Optional[T] {
+	if r.IsErr() {
+		return optional.Empty[T]()
+	}
+	return optional.New(r.value)
+}
+
+// FromFuture waits for f and returns its outcome as a Result.
+func FromFuture[T any](ctx context.Context, f future.Result[T]) Result[T] {
+	return New(f.Get(ctx))
+}
+
+func Map[F, T any](r Result[F], f types.Function[F, T]) Result[T] {
+	return monad.Map[Result[T]](MonadImpl[F, T]{}, r, f)
+}
+
+func FlatMap[F, T any](r Result[F], f types.Function[F, Result[T]]) Result[T] {
+	return monad.FlatMap(MonadImpl[F, T]{}, r, f)
+}
+
+func Filter[T any](from Result[T], f types.Function[T, bool]) Result[T] {
+	return monad.Filter(MonadImpl[T, T]{}, from, f)
+}
+
+func IfOk[T any](from Result[T], f types.Consumer[T]) {
+	monad.Do[types.Void, Result[types.Void]](MonadImpl[T, types.Void]{}, from, f)
+}
+
+// Recover replaces an error with the Result returned by f. Ok results are returned as is.
+func Recover[T any](r Result[T], f types.Function[error, Result[T]]) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	return f.Apply(r.err)
+}
+
+// MapErr transforms the error of r with f. Ok results are returned as is.
+func MapErr[T any](r Result[T], f types.Function[error, error]) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	return Err[T](f.Apply(r.err))
+}
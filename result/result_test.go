@@ -0,0 +1,155 @@
+package result_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/Warashi/go-generics/future"
+	"github.com/Warashi/go-generics/optional"
+	"github.com/Warashi/go-generics/result"
+	"github.com/Warashi/go-generics/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+var errTest = errors.New("test")
+
+func TestMap(t *testing.T) {
+	itoa := types.Closure[int, string](strconv.Itoa)
+	tests := []struct {
+		name string
+		in   result.Result[int]
+		want result.Result[string]
+	}{
+		{name: "ok", in: result.Ok(1), want: result.Ok("1")},
+		{name: "err", in: result.Err[int](errTest), want: result.Err[string](errTest)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := result.Map[int, string](tt.in, itoa); !cmp.Equal(got, tt.want) {
+				t.Errorf("Map() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	atoi := types.Closure[string, result.Result[int]](func(s string) result.Result[int] { return result.New(strconv.Atoi(s)) })
+
+	if got, want := result.FlatMap[string, int](result.Ok("1"), atoi), result.Ok(1); !cmp.Equal(got, want) {
+		t.Errorf("FlatMap() = %v, want %v", got, want)
+	}
+	if got := result.FlatMap[string, int](result.Ok("x"), atoi); got.IsOk() {
+		t.Errorf("FlatMap().IsOk() = %v, want %v", got.IsOk(), false)
+	}
+	if got := result.FlatMap[string, int](result.Err[string](errTest), atoi); !errors.Is(got.Err(), errTest) {
+		t.Errorf("FlatMap().Err() = %v, want %v", got.Err(), errTest)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	odd := types.Closure[int, bool](func(v int) bool { return v%2 != 0 })
+	tests := []struct {
+		name string
+		in   result.Result[int]
+		want result.Result[int]
+	}{
+		{name: "odd", in: result.Ok(1), want: result.Ok(1)},
+		{name: "even", in: result.Ok(2), want: result.Err[int](result.ErrEmpty)},
+		{name: "err", in: result.Err[int](errTest), want: result.Err[int](errTest)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := result.Filter[int](tt.in, odd); !cmp.Equal(got, tt.want) {
+				t.Errorf("Filter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecover(t *testing.T) {
+	recovered := types.Closure[error, result.Result[int]](func(error) result.Result[int] { return result.Ok(0) })
+
+	if got, want := result.Recover(result.Err[int](errTest), recovered), result.Ok(0); !cmp.Equal(got, want) {
+		t.Errorf("Recover() = %v, want %v", got, want)
+	}
+	if got, want := result.Recover(result.Ok(1), recovered), result.Ok(1); !cmp.Equal(got, want) {
+		t.Errorf("Recover() = %v, want %v", got, want)
+	}
+}
+
+func TestMapErr(t *testing.T) {
+	wrap := types.Closure[error, error](func(err error) error { return fmt.Errorf("wrapped: %w", err) })
+
+	got := result.MapErr(result.Err[int](errTest), wrap)
+	if !errors.Is(got.Err(), errTest) || got.Err().Error() != "wrapped: test" {
+		t.Errorf("MapErr().Err() = %v, want %v", got.Err(), "wrapped: test")
+	}
+	if got, want := result.MapErr(result.Ok(1), wrap), result.Ok(1); !cmp.Equal(got, want) {
+		t.Errorf("MapErr() = %v, want %v", got, want)
+	}
+}
+
+func TestOptional(t *testing.T) {
+	if got, want := result.FromOptional(optional.New(1), errTest), result.Ok(1); !cmp.Equal(got, want) {
+		t.Errorf("FromOptional() = %v, want %v", got, want)
+	}
+	if got, want := result.FromOptional(optional.Empty[int](), errTest), result.Err[int](errTest); !cmp.Equal(got, want) {
+		t.Errorf("FromOptional() = %v, want %v", got, want)
+	}
+	if got, want := result.ToOptional(result.Ok(1)), optional.New(1); !cmp.Equal(got, want) {
+		t.Errorf("ToOptional() = %v, want %v", got, want)
+	}
+	if got, want := result.ToOptional(result.Err[int](errTest)), optional.Empty[int](); !cmp.Equal(got, want) {
+		t.Errorf("ToOptional() = %v, want %v", got, want)
+	}
+}
+
+func TestFromFuture(t *testing.T) {
+	f := future.Do[int](context.Background(), future.TaskFunc[int](func(context.Context) (int, error) { return 0, errTest }))
+	got := result.FromFuture(context.Background(), f)
+	if v, err := got.Get(); v != 0 || !errors.Is(err, errTest) {
+		t.Errorf("Get() = (%v, %v), want (%v, %v)", v, err, 0, errTest)
+	}
+}
+
+func TestPlus(t *testing.T) {
+	errOther := errors.New("other")
+	wrappedEmpty := result.Err[int](fmt.Errorf("wrapped: %w", result.ErrEmpty))
+	impl := result.MonadImpl[int, int]{}
+	tests := []struct {
+		name string
+		a, b result.Result[int]
+		want result.Result[int]
+	}{
+		{name: "first ok", a: result.Ok(1), b: result.Ok(2), want: result.Ok(1)},
+		{name: "second ok", a: result.Err[int](errTest), b: result.Ok(2), want: result.Ok(2)},
+		{name: "both errors", a: result.Err[int](errTest), b: result.Err[int](errOther), want: result.Err[int](errOther)},
+		{name: "error plus zero", a: result.Err[int](errTest), b: impl.Zero(), want: result.Err[int](errTest)},
+		{name: "zero plus error", a: impl.Zero(), b: result.Err[int](errTest), want: result.Err[int](errTest)},
+		{name: "wrapped ErrEmpty is not zero", a: result.Err[int](errTest), b: wrappedEmpty, want: wrappedEmpty},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := impl.Plus(tt.a, tt.b); !cmp.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEqual(t *testing.T) {
+	wrapped := result.Err[int](fmt.Errorf("wrapped: %w", errTest))
+	plain := result.Err[int](errTest)
+	if wrapped.Equal(plain) || plain.Equal(wrapped) {
+		t.Errorf("a wrapped error equals the error it wraps")
+	}
+	if !plain.Equal(result.Err[int](errTest)) {
+		t.Errorf("equal errors are not Equal")
+	}
+	if !result.Ok(1).Equal(result.Ok(1)) || result.Ok(1).Equal(plain) {
+		t.Errorf("Equal mismatch for Ok")
+	}
+}
@@ -0,0 +1,136 @@
+package sequence
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/Warashi/go-generics/genio"
+	"github.com/Warashi/go-generics/minmax"
+	"github.com/Warashi/go-generics/zero"
+)
+
+var (
+	_ Sequence[any] = (*ChannelSequence[any])(nil)
+	_ Errer         = (*ChannelSequence[any])(nil)
+
+	_ Sequence[any] = (*ReaderSequence[any])(nil)
+	_ Errer         = (*ReaderSequence[any])(nil)
+	_ io.Closer     = (*ReaderSequence[any])(nil)
+)
+
+// ChannelSequence receives its values from a channel.
+type ChannelSequence[T any] struct {
+	ctx   context.Context
+	ch    <-chan T
+	value T
+	err   error
+}
+
+// Next blocks until a value is received. It returns false once the channel is closed or ctx is done.
+func (s *ChannelSequence[T]) Next() bool {
+	if s.err != nil {
+		return false
+	}
+	select {
+	case <-s.ctx.Done():
+		s.err = s.ctx.Err()
+		return false
+	case v, ok := <-s.ch:
+		s.value = v
+		return ok
+	}
+}
+
+func (s *ChannelSequence[T]) Value() T {
+	return s.value
+}
+
+// Err returns ctx.Err() if the Sequence ended because ctx is done.
+func (s *ChannelSequence[T]) Err() error {
+	return s.err
+}
+
+func FromChannel[T any](ctx context.Context, ch <-chan T) Sequence[T] {
+	return &ChannelSequence[T]{ctx: ctx, ch: ch}
+}
+
+// ToChannel sends the values of s to the returned channel from a new goroutine.
+// The goroutine stops when s ends or ctx is done, then closes s and both channels.
+// The error channel receives the error that ended s, ctx.Err() or the error of closing s, if any.
+func ToChannel[T any](ctx context.Context, s Sequence[T]) (<-chan T, <-chan error) {
+	ch := make(chan T)
+	errc := make(chan error, 1)
+	go func() {
+		defer close(errc)
+		defer close(ch)
+		err := func() error {
+			for s.Next() {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case ch <- s.Value():
+				}
+			}
+			return Err(s)
+		}()
+		if err = errors.Join(err, Close(s)); err != nil {
+			errc <- err
+		}
+	}()
+	return ch, errc
+}
+
+// ReaderSequence reads its values from a genio.Reader in batches.
+type ReaderSequence[T any] struct {
+	reader genio.Reader[T]
+	buffer []T
+	cursor int
+	err    error
+}
+
+func (s *ReaderSequence[T]) Next() bool {
+	for s.cursor+1 >= len(s.buffer) {
+		if s.err != nil {
+			return false
+		}
+		var n int
+		n, s.err = s.reader.Read(s.buffer[:cap(s.buffer)])
+		s.buffer, s.cursor = s.buffer[:n], -1
+	}
+	s.cursor++
+	return true
+}
+
+func (s *ReaderSequence[T]) Value() T {
+	if s.cursor < 0 || s.cursor >= len(s.buffer) {
+		return zero.New[T]()
+	}
+	return s.buffer[s.cursor]
+}
+
+// Err returns the error returned by the Reader. io.EOF is a clean end and is reported as nil.
+func (s *ReaderSequence[T]) Err() error {
+	if errors.Is(s.err, io.EOF) {
+		return nil
+	}
+	return s.err
+}
+
+// Close closes the Reader if it implements io.Closer.
+func (s *ReaderSequence[T]) Close() error {
+	if c, ok := s.reader.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// FromReader returns a Sequence over the elements of r, reading up to batch elements at a time.
+// batch less than 1 is treated as 1.
+func FromReader[T any](r genio.Reader[T], batch int) Sequence[T] {
+	return &ReaderSequence[T]{
+		reader: r,
+		buffer: make([]T, 0, minmax.Max(batch, 1)),
+		cursor: -1,
+	}
+}
@@ -0,0 +1,98 @@
+package sequence_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Warashi/go-generics/genio"
+	"github.com/Warashi/go-generics/sequence"
+)
+
+func TestChannel(t *testing.T) {
+	ch, errc := sequence.ToChannel(context.Background(), sequence.Range(0, 5))
+	got, err := sequence.TryCollect(sequence.FromChannel(context.Background(), ch))
+	if err != nil {
+		t.Errorf("err = %v", err)
+	}
+	if want := []int{0, 1, 2, 3, 4}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if err := <-errc; err != nil {
+		t.Errorf("<-errc = %v", err)
+	}
+
+	t.Run("ToChannel canceled", func(t *testing.T) {
+		r := &resource{}
+		ctx, cancel := context.WithCancel(context.Background())
+		ch, errc := sequence.ToChannel[int](ctx, newClosable(r, 1, 2, 3))
+		<-ch
+		cancel()
+		if err := <-errc; !errors.Is(err, context.Canceled) {
+			t.Errorf("<-errc = %v, want %v", err, context.Canceled)
+		}
+		if r.closed != 1 {
+			t.Errorf("closed = %d, want 1", r.closed)
+		}
+	})
+	t.Run("FromChannel canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		s := sequence.FromChannel(ctx, make(chan int))
+		if s.Next() {
+			t.Errorf("s.Next() = %v, want %v", true, false)
+		}
+		if err := sequence.Err(s); !errors.Is(err, context.Canceled) {
+			t.Errorf("Err() = %v, want %v", err, context.Canceled)
+		}
+	})
+}
+
+// countingReader records the length of each Read.
+type countingReader struct {
+	genio.Reader[int]
+	reads []int
+}
+
+func (r *countingReader) Read(p []int) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.reads = append(r.reads, n)
+	return n, err
+}
+
+func TestFromReader(t *testing.T) {
+	r := &countingReader{Reader: genio.NewSliceReader([]int{0, 1, 2, 3, 4})}
+	got, err := sequence.TryCollect(sequence.FromReader[int](r, 2))
+	if err != nil {
+		t.Errorf("err = %v", err)
+	}
+	if want := []int{0, 1, 2, 3, 4}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if want := []int{2, 2, 1, 0}; !cmp.Equal(r.reads, want) {
+		t.Errorf("reads = %v, want %v", r.reads, want)
+	}
+}
+
+func TestReaderFromSequence(t *testing.T) {
+	// sequence -> genio.Reader -> sequence
+	got, err := sequence.TryCollect(sequence.FromReader[int](genio.ReaderFromSequence[int](sequence.Range(0, 5)), 3))
+	if err != nil {
+		t.Errorf("err = %v", err)
+	}
+	if want := []int{0, 1, 2, 3, 4}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	t.Run("error", func(t *testing.T) {
+		got, err := genio.ReadAll[int](genio.ReaderFromSequence[int](newBroken(1, 2)))
+		if !errors.Is(err, errBroken) {
+			t.Errorf("err = %v, want %v", err, errBroken)
+		}
+		if want := []int{1, 2}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+}
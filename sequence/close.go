@@ -0,0 +1,83 @@
+package sequence
+
+import (
+	"errors"
+	"io"
+
+	"github.com/Warashi/go-generics/types"
+)
+
+var (
+	_ Sequence[any] = (*UsingSequence[io.Closer, any])(nil)
+	_ Errer         = (*UsingSequence[io.Closer, any])(nil)
+	_ io.Closer     = (*UsingSequence[io.Closer, any])(nil)
+)
+
+// Close closes s if it implements io.Closer.
+// Sequences in this package that wrap other Sequences implement io.Closer and forward Close to the wrapped ones,
+// so closing the outermost Sequence of a pipeline releases the resources of all of them.
+func Close[T any](s Sequence[T]) error {
+	if c, ok := s.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// UsingSequence closes a resource together with the Sequence reading from it.
+type UsingSequence[R io.Closer, T any] struct {
+	resource R
+	base     Sequence[T]
+	closed   bool
+	err      error
+}
+
+func (s *UsingSequence[R, T]) Next() bool {
+	if s.closed {
+		return false
+	}
+	if s.base.Next() {
+		return true
+	}
+	s.err = s.Close()
+	return false
+}
+
+func (s *UsingSequence[R, T]) Value() T {
+	return s.base.Value()
+}
+
+// Err returns the error that ended the Sequence and the error of closing it, if any.
+func (s *UsingSequence[R, T]) Err() error {
+	return errors.Join(Err(s.base), s.err)
+}
+
+// Close closes the Sequence and then the resource. It is safe to call Close more than once.
+func (s *UsingSequence[R, T]) Close() error {
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	return errors.Join(Close(s.base), s.resource.Close())
+}
+
+// Using returns the Sequence built by f over resource, closing resource once the Sequence ends or is closed.
+func Using[R io.Closer, T any](resource R, f types.Function[R, Sequence[T]]) Sequence[T] {
+	return &UsingSequence[R, T]{
+		resource: resource,
+		base:     f.Apply(resource),
+	}
+}
+
+// ForEachUntil calls f for each value of s until f returns false, and then closes s.
+// s is closed even when f panics. It returns the error that ended s and the error of closing it, if any.
+func ForEachUntil[T any](s Sequence[T], f types.Function[T, bool]) (err error) {
+	defer func() {
+		err = errors.Join(err, Close(s))
+	}()
+	for s.Next() {
+		if !f.Apply(s.Value()) {
+			return nil
+		}
+	}
+	return Err(s)
+}
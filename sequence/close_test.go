@@ -0,0 +1,172 @@
+package sequence_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Warashi/go-generics/sequence"
+	"github.com/Warashi/go-generics/types"
+)
+
+// resource is a fake resource recording how many times it is closed.
+type resource struct {
+	closed int
+	err    error
+}
+
+func (r *resource) Close() error {
+	r.closed++
+	return r.err
+}
+
+// closable is a Sequence over values that closes its resource.
+type closable struct {
+	sequence.Sequence[int]
+	resource *resource
+}
+
+func newClosable(r *resource, values ...int) *closable {
+	return &closable{Sequence: sequence.Of(values...), resource: r}
+}
+
+func (s *closable) Close() error {
+	return s.resource.Close()
+}
+
+func TestClose(t *testing.T) {
+	t.Run("FlatMap closes exhausted inner sequences", func(t *testing.T) {
+		resources := []*resource{{}, {}}
+		s := sequence.FlatMap[int, int](sequence.Of(0, 1), types.Closure[int, sequence.Sequence[int]](func(i int) sequence.Sequence[int] {
+			return newClosable(resources[i], i)
+		}))
+		if !s.Next() || !s.Next() {
+			t.Fatalf("s.Next() = false, want true")
+		}
+		if resources[0].closed != 1 || resources[1].closed != 0 {
+			t.Errorf("closed = %d, %d, want 1, 0", resources[0].closed, resources[1].closed)
+		}
+		if err := sequence.Close(s); err != nil {
+			t.Errorf("Close() = %v", err)
+		}
+		if resources[0].closed != 1 || resources[1].closed != 1 {
+			t.Errorf("closed = %d, %d, want 1, 1", resources[0].closed, resources[1].closed)
+		}
+	})
+	t.Run("Map forwards to base", func(t *testing.T) {
+		r := &resource{}
+		inc := types.Closure[int, int](func(v int) int { return v + 1 })
+		s := sequence.Take(sequence.Map[int, int](newClosable(r, 1, 2, 3), inc), 1)
+		s.Next()
+		if err := sequence.Close(s); err != nil {
+			t.Errorf("Close() = %v", err)
+		}
+		if r.closed != 1 {
+			t.Errorf("closed = %d, want 1", r.closed)
+		}
+	})
+	t.Run("Plus closes both", func(t *testing.T) {
+		a, b := &resource{}, &resource{}
+		s := sequence.MonadImpl[int, int]{}.Plus(newClosable(a, 1), newClosable(b, 2))
+		if err := sequence.Close(s); err != nil {
+			t.Errorf("Close() = %v", err)
+		}
+		if a.closed != 1 || b.closed != 1 {
+			t.Errorf("closed = %d, %d, want 1, 1", a.closed, b.closed)
+		}
+	})
+	t.Run("Unzip closes base once both halves are closed", func(t *testing.T) {
+		r := &resource{}
+		pairs := sequence.Map[int, types.Pair[int, int]](newClosable(r, 1, 2), types.Closure[int, types.Pair[int, int]](func(v int) types.Pair[int, int] {
+			return types.NewPair(v, -v)
+		}))
+		first, second := sequence.Unzip(pairs)
+		first.Next()
+		if err := sequence.Close(first); err != nil {
+			t.Errorf("Close() = %v", err)
+		}
+		sequence.Close(first)
+		if r.closed != 0 {
+			t.Errorf("closed = %d after closing one half, want 0", r.closed)
+		}
+		if first.Next() {
+			t.Errorf("first.Next() = true after Close, want false")
+		}
+		if !second.Next() || second.Value() != -1 {
+			t.Errorf("second does not yield the buffered value")
+		}
+		if err := sequence.Close(second); err != nil {
+			t.Errorf("Close() = %v", err)
+		}
+		sequence.Close(second)
+		if r.closed != 1 {
+			t.Errorf("closed = %d, want 1", r.closed)
+		}
+	})
+	t.Run("Partition forwards to base", func(t *testing.T) {
+		r := &resource{}
+		even, odd := sequence.Partition(newClosable(r, 1, 2, 3), types.Closure[int, bool](func(v int) bool { return v%2 == 0 }))
+		sequence.Close(even)
+		sequence.Close(odd)
+		if r.closed != 1 {
+			t.Errorf("closed = %d, want 1", r.closed)
+		}
+	})
+}
+
+func TestUsing(t *testing.T) {
+	open := types.Closure[*resource, sequence.Sequence[int]](func(r *resource) sequence.Sequence[int] { return sequence.Of(1, 2) })
+
+	t.Run("exhausted", func(t *testing.T) {
+		r := &resource{}
+		s := sequence.Using[*resource, int](r, open)
+		if got, want := sequence.Collect(s), []int{1, 2}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+		if err := sequence.Close(s); err != nil {
+			t.Errorf("Close() = %v", err)
+		}
+		if r.closed != 1 {
+			t.Errorf("closed = %d, want 1", r.closed)
+		}
+	})
+	t.Run("close error", func(t *testing.T) {
+		errClose := errors.New("close")
+		r := &resource{err: errClose}
+		_, err := sequence.TryCollect(sequence.Using[*resource, int](r, open))
+		if !errors.Is(err, errClose) {
+			t.Errorf("err = %v, want %v", err, errClose)
+		}
+	})
+}
+
+func TestForEachUntil(t *testing.T) {
+	t.Run("early stop", func(t *testing.T) {
+		r := &resource{}
+		var got []int
+		err := sequence.ForEachUntil[int](newClosable(r, 1, 2, 3), types.Closure[int, bool](func(v int) bool {
+			got = append(got, v)
+			return v < 2
+		}))
+		if err != nil {
+			t.Errorf("ForEachUntil() = %v", err)
+		}
+		if want := []int{1, 2}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+		if r.closed != 1 {
+			t.Errorf("closed = %d, want 1", r.closed)
+		}
+	})
+	t.Run("panic", func(t *testing.T) {
+		r := &resource{}
+		func() {
+			defer func() { recover() }()
+			sequence.ForEachUntil[int](newClosable(r, 1), types.Closure[int, bool](func(int) bool { panic("boom") }))
+		}()
+		if r.closed != 1 {
+			t.Errorf("closed = %d, want 1", r.closed)
+		}
+	})
+}
@@ -0,0 +1,74 @@
+package sequence
+
+import (
+	"errors"
+	"io"
+
+	"github.com/Warashi/go-generics/types"
+)
+
+var (
+	_ Sequence[any] = (*ScannerSequence[any])(nil)
+	_ Errer         = (*ScannerSequence[any])(nil)
+)
+
+// Errer is implemented by Sequences that can end because of an error, like bufio.Scanner.
+// When Next returns false, Err reports whether the Sequence ended cleanly (nil) or not.
+// Sequences in this package that wrap other Sequences implement Errer and forward the errors of the wrapped ones.
+type Errer interface {
+	Err() error
+}
+
+// Err returns the error that ended s, or nil if s ended cleanly or does not implement Errer.
+func Err[T any](s Sequence[T]) error {
+	if e, ok := s.(Errer); ok {
+		return e.Err()
+	}
+	return nil
+}
+
+// TryCollect is like Collect but also returns the error that ended s.
+// The values read before the error are returned along with it.
+func TryCollect[T any](s Sequence[T]) ([]T, error) {
+	v := Collect(s)
+	return v, Err(s)
+}
+
+// TryForEach is like ForEach but also returns the error that ended s.
+func TryForEach[T any](s Sequence[T], f types.Consumer[T]) error {
+	ForEach(s, f)
+	return Err(s)
+}
+
+// Scanner is the Scan/Value/Err protocol of jsonutil.Scanner.
+type Scanner[T any] interface {
+	Scan() bool
+	Value() T
+	Err() error
+}
+
+// ScannerSequence adapts a Scanner to Sequence.
+type ScannerSequence[T any] struct {
+	scanner Scanner[T]
+}
+
+func (s *ScannerSequence[T]) Next() bool {
+	return s.scanner.Scan()
+}
+
+func (s *ScannerSequence[T]) Value() T {
+	return s.scanner.Value()
+}
+
+// Err returns the error of the Scanner. io.EOF is a clean end and is reported as nil.
+func (s *ScannerSequence[T]) Err() error {
+	if err := s.scanner.Err(); !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
+}
+
+// FromScanner returns a Sequence over the values of s, e.g. a jsonutil.Scanner.
+func FromScanner[T any](s Scanner[T]) Sequence[T] {
+	return &ScannerSequence[T]{scanner: s}
+}
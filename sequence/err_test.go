@@ -0,0 +1,112 @@
+package sequence_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Warashi/go-generics/jsonutil"
+	"github.com/Warashi/go-generics/sequence"
+	"github.com/Warashi/go-generics/types"
+)
+
+var errBroken = errors.New("broken")
+
+// broken returns its values and then ends with errBroken.
+type broken struct {
+	values []int
+	cursor int
+}
+
+func newBroken(values ...int) *broken {
+	return &broken{values: values, cursor: -1}
+}
+
+func (s *broken) Next() bool {
+	if s.cursor+1 >= len(s.values) {
+		s.cursor = len(s.values)
+		return false
+	}
+	s.cursor++
+	return true
+}
+
+func (s *broken) Value() int {
+	return s.values[s.cursor]
+}
+
+func (s *broken) Err() error {
+	if s.cursor >= len(s.values) {
+		return errBroken
+	}
+	return nil
+}
+
+func TestErr(t *testing.T) {
+	inc := types.Closure[int, int](func(v int) int { return v + 1 })
+	tests := []struct {
+		name    string
+		s       sequence.Sequence[int]
+		want    []int
+		wantErr error
+	}{
+		{name: "clean", s: sequence.Of(1, 2), want: []int{1, 2}},
+		{name: "Map", s: sequence.Map[int, int](newBroken(1, 2), inc), want: []int{2, 3}, wantErr: errBroken},
+		{
+			name: "FlatMap inner",
+			s: sequence.FlatMap[int, int](sequence.Of(1, 2), types.Closure[int, sequence.Sequence[int]](func(v int) sequence.Sequence[int] {
+				if v == 1 {
+					return newBroken(10)
+				}
+				return sequence.Of(20)
+			})),
+			want:    []int{10},
+			wantErr: errBroken,
+		},
+		{name: "Plus first", s: sequence.MonadImpl[int, int]{}.Plus(newBroken(1), sequence.Of(2)), want: []int{1}, wantErr: errBroken},
+		{name: "Plus second", s: sequence.MonadImpl[int, int]{}.Plus(sequence.Of(1), newBroken(2)), want: []int{1, 2}, wantErr: errBroken},
+		{name: "Take", s: sequence.Take[int](newBroken(1), 3), want: []int{1}, wantErr: errBroken},
+		{name: "Take before error", s: sequence.Take[int](newBroken(1, 2), 1), want: []int{1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := sequence.TryCollect(tt.s)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromScanner(t *testing.T) {
+	type record struct {
+		ID int `json:"id"`
+	}
+	id := types.Closure[record, int](func(r record) int { return r.ID })
+
+	t.Run("clean", func(t *testing.T) {
+		s := sequence.Map[record, int](sequence.FromScanner[record](jsonutil.NewScanner[record](strings.NewReader(`{"id":1} {"id":2}`))), id)
+		got, err := sequence.TryCollect(s)
+		if err != nil {
+			t.Errorf("err = %v, want nil", err)
+		}
+		if want := []int{1, 2}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+	t.Run("broken", func(t *testing.T) {
+		s := sequence.Map[record, int](sequence.FromScanner[record](jsonutil.NewScanner[record](strings.NewReader(`{"id":1} {"id":`))), id)
+		got, err := sequence.TryCollect(s)
+		if err == nil {
+			t.Errorf("err = %v, want error", err)
+		}
+		if want := []int{1}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+}
@@ -0,0 +1,148 @@
+package sequence
+
+import (
+	"io"
+
+	"golang.org/x/exp/constraints"
+
+	"github.com/Warashi/go-generics/optional"
+	"github.com/Warashi/go-generics/types"
+	"github.com/Warashi/go-generics/zero"
+)
+
+var (
+	_ Sequence[any] = (*GenerateSequence[any])(nil)
+	_ Sequence[any] = (*CycleSequence[any])(nil)
+	_ Errer         = (*CycleSequence[any])(nil)
+	_ io.Closer     = (*CycleSequence[any])(nil)
+)
+
+type GenerateSequence[T any] struct {
+	function func() (T, bool)
+	value    T
+	done     bool
+}
+
+func (s *GenerateSequence[T]) Next() bool {
+	if s.done {
+		return false
+	}
+	var ok bool
+	s.value, ok = s.function()
+	if !ok {
+		s.done = true
+		s.value = zero.New[T]()
+	}
+	return ok
+}
+
+func (s *GenerateSequence[T]) Value() T {
+	return s.value
+}
+
+// Generate returns a Sequence calling f on each Next. It ends when f returns false, and f is never called again.
+func Generate[T any](f func() (T, bool)) Sequence[T] {
+	return &GenerateSequence[T]{function: f}
+}
+
+// Range returns a Sequence of start, start+1, ..., end-1.
+func Range[T constraints.Integer](start, end T) Sequence[T] {
+	return RangeBy(start, end, 1)
+}
+
+// RangeBy returns a Sequence from start toward end, exclusive, advancing by step.
+// step may be negative. RangeBy panics if step is zero.
+func RangeBy[T constraints.Integer](start, end, step T) Sequence[T] {
+	if step == 0 {
+		panic("zero step for sequence.RangeBy")
+	}
+	next, done := start, (step > 0 && start >= end) || (step < 0 && start <= end)
+	return Generate(func() (T, bool) {
+		if done {
+			return zero.New[T](), false
+		}
+		v := next
+		next += step
+		// next wraps around instead of passing end near the bounds of T.
+		done = (step > 0 && (next < v || next >= end)) || (step < 0 && (next > v || next <= end))
+		return v, true
+	})
+}
+
+// Iterate returns the infinite Sequence seed, f(seed), f(f(seed)), ...
+func Iterate[T any](seed T, f types.Function[T, T]) Sequence[T] {
+	next := seed
+	return Generate(func() (T, bool) {
+		v := next
+		next = f.Apply(next)
+		return v, true
+	})
+}
+
+// Repeat returns an infinite Sequence of value. Use Take to bound it.
+func Repeat[T any](value T) Sequence[T] {
+	return Generate(func() (T, bool) { return value, true })
+}
+
+// Unfold returns a Sequence built from seed: f returns the next value and state, or empty to end the Sequence.
+func Unfold[S, T any](seed S, f types.Function[S, optional.Optional[types.Pair[T, S]]]) Sequence[T] {
+	state := seed
+	return Generate(func() (T, bool) {
+		o := f.Apply(state)
+		if o.IsEmpty() {
+			return zero.New[T](), false
+		}
+		var v T
+		v, state = o.OrElseZero().Values()
+		return v, true
+	})
+}
+
+type CycleSequence[T any] struct {
+	base     Sequence[T]
+	buffer   []T
+	cursor   int
+	replayed bool
+}
+
+func (s *CycleSequence[T]) Next() bool {
+	if !s.replayed {
+		if s.base.Next() {
+			s.buffer = append(s.buffer, s.base.Value())
+			s.cursor = len(s.buffer) - 1
+			return true
+		}
+		if Err(s.base) != nil {
+			return false
+		}
+		s.replayed = true
+		s.cursor = -1
+	}
+	if len(s.buffer) == 0 {
+		return false
+	}
+	s.cursor = (s.cursor + 1) % len(s.buffer)
+	return true
+}
+
+func (s *CycleSequence[T]) Value() T {
+	if s.cursor < 0 || len(s.buffer) == 0 {
+		return zero.New[T]()
+	}
+	return s.buffer[s.cursor]
+}
+
+func (s *CycleSequence[T]) Err() error {
+	return Err(s.base)
+}
+
+func (s *CycleSequence[T]) Close() error {
+	return Close(s.base)
+}
+
+// Cycle returns a Sequence repeating the values of s forever.
+// s is consumed lazily on the first pass and its values are buffered for the following ones.
+// If s is empty, so is the result.
+func Cycle[T any](s Sequence[T]) Sequence[T] {
+	return &CycleSequence[T]{base: s}
+}
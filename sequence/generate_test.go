@@ -0,0 +1,141 @@
+package sequence_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/Warashi/go-generics/optional"
+	"github.com/Warashi/go-generics/sequence"
+	"github.com/Warashi/go-generics/types"
+)
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		name string
+		s    sequence.Sequence[int]
+		want []int
+	}{
+		{name: "Range", s: sequence.Range(0, 3), want: []int{0, 1, 2}},
+		{name: "empty Range", s: sequence.Range(3, 3), want: nil},
+		{name: "RangeBy", s: sequence.RangeBy(0, 7, 3), want: []int{0, 3, 6}},
+		{name: "negative RangeBy", s: sequence.RangeBy(3, 0, -1), want: []int{3, 2, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sequence.Collect(tt.s); !cmp.Equal(got, tt.want, cmpopts.EquateEmpty()) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRangeBy_bounds(t *testing.T) {
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			start, end, step uint8
+			want             []uint8
+		}{
+			{name: "overflow", start: 250, end: 255, step: 10, want: []uint8{250}},
+			{name: "up to max", start: 245, end: 255, step: 5, want: []uint8{245, 250}},
+			{name: "Range", start: 253, end: 255, step: 1, want: []uint8{253, 254}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if got := sequence.Collect(sequence.Take(sequence.RangeBy(tt.start, tt.end, tt.step), 10)); !cmp.Equal(got, tt.want) {
+					t.Errorf("got %v, want %v", got, tt.want)
+				}
+			})
+		}
+	})
+	t.Run("int8", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			start, end, step int8
+			want             []int8
+		}{
+			{name: "overflow", start: 120, end: 127, step: 10, want: []int8{120}},
+			{name: "underflow", start: -120, end: -128, step: -10, want: []int8{-120}},
+			{name: "whole range", start: -128, end: 127, step: 100, want: []int8{-128, -28, 72}},
+			{name: "whole range down", start: 127, end: -128, step: -100, want: []int8{127, 27, -73}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if got := sequence.Collect(sequence.Take(sequence.RangeBy(tt.start, tt.end, tt.step), 10)); !cmp.Equal(got, tt.want) {
+					t.Errorf("got %v, want %v", got, tt.want)
+				}
+			})
+		}
+	})
+}
+
+func TestRangeBy_zeroStep(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("RangeBy(0, 10, 0) did not panic")
+		}
+	}()
+	sequence.RangeBy(0, 10, 0)
+}
+
+func TestIterate(t *testing.T) {
+	double := types.Closure[int, int](func(v int) int { return v * 2 })
+	if got, want := sequence.Collect(sequence.Take(sequence.Iterate[int](1, double), 4)), []int{1, 2, 4, 8}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestRepeat(t *testing.T) {
+	s := sequence.MonadImpl[int, int]{}.Plus(sequence.Take(sequence.Repeat(1), 2), sequence.Range(5, 7))
+	if got, want := sequence.Collect(s), []int{1, 1, 5, 6}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	t.Run("bind", func(t *testing.T) {
+		s := sequence.FlatMap[int, int](sequence.Range(1, 4), types.Closure[int, sequence.Sequence[int]](func(n int) sequence.Sequence[int] {
+			return sequence.Take(sequence.Repeat(n), n)
+		}))
+		if got, want := sequence.Collect(s), []int{1, 2, 2, 3, 3, 3}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+}
+
+func TestCycle(t *testing.T) {
+	if got, want := sequence.Collect(sequence.Take(sequence.Cycle(sequence.Of(1, 2, 3)), 7)), []int{1, 2, 3, 1, 2, 3, 1}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := sequence.Collect(sequence.Cycle(sequence.Of[int]())); len(got) != 0 {
+		t.Errorf("got %v, want empty", got)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	calls := 0
+	s := sequence.Generate(func() (int, bool) {
+		calls++
+		return calls, calls <= 2
+	})
+	if got, want := sequence.Collect(s), []int{1, 2}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if s.Next() || calls != 3 {
+		t.Errorf("f called %d times after end, want %d", calls, 3)
+	}
+}
+
+func TestUnfold(t *testing.T) {
+	fib := types.Closure[types.Pair[int, int], optional.Optional[types.Pair[int, types.Pair[int, int]]]](
+		func(s types.Pair[int, int]) optional.Optional[types.Pair[int, types.Pair[int, int]]] {
+			if s.First > 20 {
+				return optional.Empty[types.Pair[int, types.Pair[int, int]]]()
+			}
+			return optional.New(types.NewPair(s.First, types.NewPair(s.Second, s.First+s.Second)))
+		})
+	got := sequence.Collect(sequence.Unfold[types.Pair[int, int], int](types.NewPair(0, 1), fib))
+	if want := []int{0, 1, 1, 2, 3, 5, 8, 13}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,177 @@
+package sequence
+
+import (
+	"io"
+
+	"github.com/Warashi/go-generics/minmax"
+	"github.com/Warashi/go-generics/types"
+)
+
+var (
+	_ Sequence[[]any] = (*ChunkSequence[any])(nil)
+	_ Sequence[[]any] = (*WindowSequence[any])(nil)
+	_ Sequence[[]any] = (*ChunkBySequence[any, int])(nil)
+
+	_ Errer = (*ChunkSequence[any])(nil)
+	_ Errer = (*WindowSequence[any])(nil)
+	_ Errer = (*ChunkBySequence[any, int])(nil)
+
+	_ io.Closer = (*ChunkSequence[any])(nil)
+	_ io.Closer = (*WindowSequence[any])(nil)
+	_ io.Closer = (*ChunkBySequence[any, int])(nil)
+)
+
+type ChunkSequence[T any] struct {
+	base  Sequence[T]
+	n     int
+	value []T
+	done  bool
+}
+
+func (s *ChunkSequence[T]) Next() bool {
+	s.value = nil
+	for !s.done && len(s.value) < s.n {
+		if !s.base.Next() {
+			s.done = true
+			break
+		}
+		s.value = append(s.value, s.base.Value())
+	}
+	return len(s.value) > 0
+}
+
+func (s *ChunkSequence[T]) Value() []T {
+	return s.value
+}
+
+func (s *ChunkSequence[T]) Err() error {
+	return Err(s.base)
+}
+
+func (s *ChunkSequence[T]) Close() error {
+	return Close(s.base)
+}
+
+// Chunk splits s into consecutive slices of n values. The last chunk may be shorter.
+// n less than 1 is treated as 1.
+func Chunk[T any](s Sequence[T], n int) Sequence[[]T] {
+	return &ChunkSequence[T]{base: s, n: minmax.Max(n, 1)}
+}
+
+// WindowSequence returns windows of size values, sliding by step.
+// Each window is a newly allocated slice, so it can be retained by the caller.
+type WindowSequence[T any] struct {
+	base   Sequence[T]
+	size   int
+	step   int
+	buffer []T
+	value  []T
+	done   bool
+}
+
+func (s *WindowSequence[T]) Next() bool {
+	if s.done {
+		return false
+	}
+	if s.value != nil {
+		if s.step < s.size {
+			s.buffer = append(s.buffer[:0], s.buffer[s.step:]...)
+		} else {
+			s.buffer = s.buffer[:0]
+			for i := s.size; i < s.step; i++ {
+				if !s.base.Next() {
+					s.done = true
+					return false
+				}
+			}
+		}
+	}
+	for len(s.buffer) < s.size {
+		if !s.base.Next() {
+			s.done = true
+			return false
+		}
+		s.buffer = append(s.buffer, s.base.Value())
+	}
+	s.value = append(make([]T, 0, s.size), s.buffer...)
+	return true
+}
+
+func (s *WindowSequence[T]) Value() []T {
+	return s.value
+}
+
+func (s *WindowSequence[T]) Err() error {
+	return Err(s.base)
+}
+
+func (s *WindowSequence[T]) Close() error {
+	return Close(s.base)
+}
+
+// Window returns the sliding windows of size values of s, moving by step values each time.
+// Only full windows are returned. size and step less than 1 are treated as 1.
+func Window[T any](s Sequence[T], size, step int) Sequence[[]T] {
+	return &WindowSequence[T]{base: s, size: minmax.Max(size, 1), step: minmax.Max(step, 1)}
+}
+
+type ChunkBySequence[T any, K comparable] struct {
+	base    Sequence[T]
+	key     types.Function[T, K]
+	pending []T
+	value   []T
+}
+
+func (s *ChunkBySequence[T, K]) Next() bool {
+	s.value, s.pending = s.pending, nil
+	for s.base.Next() {
+		v := s.base.Value()
+		if len(s.value) > 0 && s.key.Apply(s.value[0]) != s.key.Apply(v) {
+			s.pending = []T{v}
+			return true
+		}
+		s.value = append(s.value, v)
+	}
+	return len(s.value) > 0
+}
+
+func (s *ChunkBySequence[T, K]) Value() []T {
+	return s.value
+}
+
+func (s *ChunkBySequence[T, K]) Err() error {
+	return Err(s.base)
+}
+
+func (s *ChunkBySequence[T, K]) Close() error {
+	return Close(s.base)
+}
+
+// ChunkBy splits s into runs of consecutive values having the same key.
+// It reads one value ahead of the run it returns.
+func ChunkBy[T any, K comparable](s Sequence[T], key types.Function[T, K]) Sequence[[]T] {
+	return &ChunkBySequence[T, K]{base: s, key: key}
+}
+
+// GroupBy consumes s and groups its values by key, preserving their order within each group.
+func GroupBy[T any, K comparable](s Sequence[T], key types.Function[T, K]) map[K][]T {
+	groups := make(map[K][]T)
+	ForEach(s, types.NewConsumer(func(v T) {
+		k := key.Apply(v)
+		groups[k] = append(groups[k], v)
+	}))
+	return groups
+}
+
+// Partition splits s into the values for which f returns true and the others.
+// Both Sequences can be consumed independently; values read by only one of them are buffered for the other.
+// s is closed once both of them are closed.
+func Partition[T any](s Sequence[T], f types.Function[T, bool]) (Sequence[T], Sequence[T]) {
+	matched, unmatched := Unzip(Map[T, types.Pair[Sequence[T], Sequence[T]]](s, types.Closure[T, types.Pair[Sequence[T], Sequence[T]]](func(v T) types.Pair[Sequence[T], Sequence[T]] {
+		if f.Apply(v) {
+			return types.NewPair(Of(v), Of[T]())
+		}
+		return types.NewPair(Of[T](), Of(v))
+	})))
+	return Flatten(matched), Flatten(unmatched)
+}
@@ -0,0 +1,90 @@
+package sequence_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/Warashi/go-generics/sequence"
+	"github.com/Warashi/go-generics/types"
+)
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		n    int
+		want [][]int
+	}{
+		{name: "even", in: []int{0, 1, 2, 3}, n: 2, want: [][]int{{0, 1}, {2, 3}}},
+		{name: "remainder", in: []int{0, 1, 2}, n: 2, want: [][]int{{0, 1}, {2}}},
+		{name: "empty", in: nil, n: 2, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sequence.Collect(sequence.Chunk(sequence.Of(tt.in...), tt.n)); !cmp.Equal(got, tt.want, cmpopts.EquateEmpty()) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("infinite", func(t *testing.T) {
+		base := &naturals{}
+		if got, want := sequence.Collect(sequence.Take(sequence.Chunk[int](base, 3), 2)), [][]int{{0, 1, 2}, {3, 4, 5}}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+		if base.next != 6 {
+			t.Errorf("base.Next() called %d times, want %d", base.next, 6)
+		}
+	})
+}
+
+func TestWindow(t *testing.T) {
+	tests := []struct {
+		name       string
+		in         []int
+		size, step int
+		want       [][]int
+	}{
+		{name: "sliding", in: []int{0, 1, 2, 3}, size: 2, step: 1, want: [][]int{{0, 1}, {1, 2}, {2, 3}}},
+		{name: "tumbling", in: []int{0, 1, 2, 3, 4}, size: 2, step: 2, want: [][]int{{0, 1}, {2, 3}}},
+		{name: "hopping", in: []int{0, 1, 2, 3, 4, 5, 6}, size: 2, step: 3, want: [][]int{{0, 1}, {3, 4}}},
+		{name: "too short", in: []int{0}, size: 2, step: 1, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sequence.Collect(sequence.Window(sequence.Of(tt.in...), tt.size, tt.step))
+			if !cmp.Equal(got, tt.want, cmpopts.EquateEmpty()) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChunkBy(t *testing.T) {
+	parity := types.Closure[int, int](func(v int) int { return v % 2 })
+	got := sequence.Collect(sequence.ChunkBy[int, int](sequence.Of(1, 3, 2, 4, 5), parity))
+	if want := [][]int{{1, 3}, {2, 4}, {5}}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	parity := types.Closure[int, int](func(v int) int { return v % 2 })
+	got := sequence.GroupBy[int, int](sequence.Of(1, 3, 2, 4, 5), parity)
+	if want := map[int][]int{0: {2, 4}, 1: {1, 3, 5}}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	even := types.Closure[int, bool](func(v int) bool { return v%2 == 0 })
+	evens, odds := sequence.Partition[int](sequence.Range(0, 6), even)
+	if got, want := sequence.Collect(odds), []int{1, 3, 5}; !cmp.Equal(got, want) {
+		t.Errorf("odds = %v, want %v", got, want)
+	}
+	if got, want := sequence.Collect(evens), []int{0, 2, 4}; !cmp.Equal(got, want) {
+		t.Errorf("evens = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,78 @@
+package sequence
+
+import (
+	"io"
+	"iter"
+
+	"github.com/Warashi/go-generics/types"
+	"github.com/Warashi/go-generics/zero"
+)
+
+var (
+	_ Sequence[any] = (*SeqSequence[any])(nil)
+	_ io.Closer     = (*SeqSequence[any])(nil)
+)
+
+// All returns an iterator over the remaining values of s, for use with for range and the iterator helpers of slices and maps.
+// Breaking out of the loop leaves the rest of s unconsumed.
+func All[T any](s Sequence[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for s.Next() {
+			if !yield(s.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// AllIndexed is like All but also yields the index of each value, counting from 0.
+func AllIndexed[T any](s Sequence[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; s.Next(); i++ {
+			if !yield(i, s.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// SeqSequence is a Sequence pulling its values from an iter.Seq.
+type SeqSequence[T any] struct {
+	next  func() (T, bool)
+	stop  func()
+	value T
+}
+
+func (s *SeqSequence[T]) Next() bool {
+	var ok bool
+	s.value, ok = s.next()
+	return ok
+}
+
+func (s *SeqSequence[T]) Value() T {
+	return s.value
+}
+
+// Close stops the underlying iterator. It must be called when s is abandoned before Next returns false.
+func (s *SeqSequence[T]) Close() error {
+	s.stop()
+	s.value = zero.New[T]()
+	return nil
+}
+
+// FromSeq returns a Sequence over seq. Values are pulled lazily, one per call to Next.
+func FromSeq[T any](seq iter.Seq[T]) *SeqSequence[T] {
+	next, stop := iter.Pull(seq)
+	return &SeqSequence[T]{next: next, stop: stop}
+}
+
+// FromSeq2 returns a Sequence over seq, combining each pair with f.
+func FromSeq2[K, V, T any](seq iter.Seq2[K, V], f types.BiFunction[K, V, T]) *SeqSequence[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for k, v := range seq {
+			if !yield(f.Apply(k, v)) {
+				return
+			}
+		}
+	})
+}
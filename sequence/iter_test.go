@@ -0,0 +1,82 @@
+package sequence_test
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Warashi/go-generics/sequence"
+	"github.com/Warashi/go-generics/types"
+)
+
+func TestAll(t *testing.T) {
+	var got []int
+	for v := range sequence.All(sequence.Of(0, 1, 2)) {
+		got = append(got, v)
+	}
+	if want := []int{0, 1, 2}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	t.Run("break", func(t *testing.T) {
+		s := sequence.Of(0, 1, 2)
+		for v := range sequence.All(s) {
+			if v == 1 {
+				break
+			}
+		}
+		if got, want := sequence.Collect(s), []int{2}; !cmp.Equal(got, want) {
+			t.Errorf("rest = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestAllIndexed(t *testing.T) {
+	got := maps.Collect(sequence.AllIndexed(sequence.Of("a", "b")))
+	if want := map[int]string{0: "a", 1: "b"}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	s := sequence.FromSeq(slices.Values([]int{0, 1, 2}))
+	got := sequence.Collect(sequence.Map[int, string](s, types.Closure[int, string](strconv.Itoa)))
+	if want := []string{"0", "1", "2"}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	t.Run("close", func(t *testing.T) {
+		stopped := false
+		s := sequence.FromSeq(func(yield func(int) bool) {
+			defer func() { stopped = true }()
+			for i := 0; ; i++ {
+				if !yield(i) {
+					return
+				}
+			}
+		})
+		if !s.Next() || s.Value() != 0 {
+			t.Fatalf("s.Value() = %v, want %v", s.Value(), 0)
+		}
+		if err := s.Close(); err != nil {
+			t.Fatalf("s.Close() = %v", err)
+		}
+		if !stopped {
+			t.Errorf("iterator is not stopped after Close")
+		}
+		if s.Next() {
+			t.Errorf("s.Next() = %v after Close, want %v", true, false)
+		}
+	})
+}
+
+func TestFromSeq2(t *testing.T) {
+	join := types.BiClosure[int, string, string](func(i int, v string) string { return strconv.Itoa(i) + v })
+	got := sequence.Collect[string](sequence.FromSeq2[int, string, string](slices.All([]string{"a", "b"}), join))
+	if want := []string{"0a", "1b"}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
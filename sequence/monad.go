@@ -1,14 +1,25 @@
 package sequence
 
 import (
+	"errors"
+	"io"
+
 	"github.com/Warashi/go-generics/monad"
 	"github.com/Warashi/go-generics/types"
 	"github.com/Warashi/go-generics/zero"
 )
 
 var (
-	_ monad.Monad[int, string, Sequence[int], Sequence[string]]         = MonadImpl[int, string]{}
-	_ monad.AdditiveMonad[int, string, Sequence[int], Sequence[string]] = MonadImpl[int, string]{}
+	_ monad.Monad[int, string, Sequence[int], Sequence[string]]                                              = MonadImpl[int, string]{}
+	_ monad.AdditiveMonad[int, string, Sequence[int], Sequence[string]]                                      = MonadImpl[int, string]{}
+	_ monad.Foldable[int, string, Sequence[int]]                                                             = MonadImpl[int, string]{}
+	_ monad.Applicative[int, string, Sequence[int], Sequence[string], Sequence[types.Function[int, string]]] = MonadImpl[int, string]{}
+
+	_ Errer = (*BindSequence[int, string])(nil)
+	_ Errer = (*PlusSequence[int])(nil)
+
+	_ io.Closer = (*BindSequence[int, string])(nil)
+	_ io.Closer = (*PlusSequence[int])(nil)
 )
 
 type MonadImpl[T, U any] struct{}
@@ -35,16 +46,63 @@ func (MonadImpl[T, U]) Plus(a, b Sequence[T]) Sequence[T] {
 	}
 }
 
+func (MonadImpl[T, U]) FoldLeft(src Sequence[T], init U, f types.BiFunction[U, T, U]) U {
+	acc := init
+	for src.Next() {
+		acc = f.Apply(acc, src.Value())
+	}
+	return acc
+}
+
+func (MonadImpl[T, U]) FoldRight(src Sequence[T], init U, f types.BiFunction[T, U, U]) U {
+	values := Collect(src)
+	acc := init
+	for i := len(values) - 1; i >= 0; i-- {
+		acc = f.Apply(values[i], acc)
+	}
+	return acc
+}
+
+// Ap applies every function of fs to every value of src.
+// src is consumed once, on the first function, and buffered for the rest.
+func (MonadImpl[T, U]) Ap(fs Sequence[types.Function[T, U]], src Sequence[T]) Sequence[U] {
+	var (
+		values   []T
+		buffered bool
+	)
+	return &BindSequence[types.Function[T, U], U]{
+		base: fs,
+		function: types.Closure[types.Function[T, U], Sequence[U]](func(f types.Function[T, U]) Sequence[U] {
+			if !buffered {
+				values, buffered = Collect(src), true
+			}
+			return Map(Of(values...), f)
+		}),
+	}
+}
+
 type BindSequence[T, U any] struct {
 	base     Sequence[T]
 	current  Sequence[U]
 	function types.Function[T, Sequence[U]]
+	err      error
 }
 
 func (s *BindSequence[T, U]) Next() bool {
 	for {
-		if s.current != nil && s.current.Next() {
-			return true
+		if s.err != nil {
+			return false
+		}
+		if s.current != nil {
+			if s.current.Next() {
+				return true
+			}
+			// the exhausted inner Sequence is closed before moving on to the next one.
+			s.err = errors.Join(Err(s.current), Close(s.current))
+			s.current = nil
+			if s.err != nil {
+				return false
+			}
 		}
 		if !s.base.Next() {
 			return false
@@ -60,16 +118,39 @@ func (s *BindSequence[T, U]) Value() U {
 	return s.current.Value()
 }
 
+// Err returns the error that ended the base or one of the inner Sequences, if any.
+func (s *BindSequence[T, U]) Err() error {
+	if s.err != nil {
+		return s.err
+	}
+	return Err(s.base)
+}
+
+// Close closes the current inner Sequence and the base.
+func (s *BindSequence[T, U]) Close() error {
+	var err error
+	if s.current != nil {
+		err = Close(s.current)
+		s.current = nil
+	}
+	return errors.Join(err, Close(s.base))
+}
+
 type PlusSequence[T any] struct {
 	second bool
 	base   [2]Sequence[T]
 }
 
 func (s *PlusSequence[T]) Next() bool {
-	if !s.second && s.base[0].Next() {
-		return true
+	if !s.second {
+		if s.base[0].Next() {
+			return true
+		}
+		if Err(s.base[0]) != nil {
+			return false
+		}
+		s.second = true
 	}
-	s.second = true
 	return s.base[1].Next()
 }
 
@@ -79,3 +160,17 @@ func (s *PlusSequence[T]) Value() T {
 	}
 	return s.base[1].Value()
 }
+
+// Err returns the error that ended the first or the second Sequence, if any.
+// The second Sequence is not started when the first one ends with an error.
+func (s *PlusSequence[T]) Err() error {
+	if !s.second {
+		return Err(s.base[0])
+	}
+	return Err(s.base[1])
+}
+
+// Close closes both Sequences.
+func (s *PlusSequence[T]) Close() error {
+	return errors.Join(Close(s.base[0]), Close(s.base[1]))
+}
@@ -0,0 +1,42 @@
+package sequence_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/Warashi/go-generics/monad/monadtest"
+	"github.com/Warashi/go-generics/sequence"
+	"github.com/Warashi/go-generics/types"
+)
+
+func TestMonadLaws(t *testing.T) {
+	values := func(r *rand.Rand) sequence.Sequence[int] {
+		v := make([]int, r.Intn(4))
+		for i := range v {
+			v[i] = r.Intn(10)
+		}
+		return sequence.Of(v...)
+	}
+	gen := monadtest.Generator[int, sequence.Sequence[int]]{
+		Value: func(r *rand.Rand) int { return r.Intn(10) },
+		Monad: values,
+		Function: func(r *rand.Rand) types.Function[int, sequence.Sequence[int]] {
+			k := r.Intn(5)
+			return types.Closure[int, sequence.Sequence[int]](func(x int) sequence.Sequence[int] {
+				v := make([]int, (x+k)%3)
+				for i := range v {
+					v[i] = x + k + i
+				}
+				return sequence.Of(v...)
+			})
+		},
+	}
+	equal := func(a, b sequence.Sequence[int]) bool {
+		return cmp.Equal(sequence.Collect(a), sequence.Collect(b), cmpopts.EquateEmpty())
+	}
+
+	monadtest.TestAdditiveMonad[int, sequence.Sequence[int]](t, sequence.MonadImpl[int, int]{}, gen, equal)
+}
@@ -0,0 +1,242 @@
+package sequence
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/Warashi/go-generics/minmax"
+	"github.com/Warashi/go-generics/types"
+)
+
+var (
+	_ Sequence[any] = (*PrefetchSequence[any])(nil)
+	_ Errer         = (*PrefetchSequence[any])(nil)
+	_ io.Closer     = (*PrefetchSequence[any])(nil)
+
+	_ Sequence[any] = (*ParallelMapSequence[int, any])(nil)
+	_ Errer         = (*ParallelMapSequence[int, any])(nil)
+	_ io.Closer     = (*ParallelMapSequence[int, any])(nil)
+)
+
+// errClosed is the cause of the cancellation by Close of PrefetchSequence and ParallelMapSequence.
+var errClosed = errors.New("sequence: closed")
+
+// PrefetchSequence evaluates its base ahead of the consumer in a separate goroutine.
+type PrefetchSequence[T any] struct {
+	ch     <-chan T
+	done   <-chan struct{}
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	value  T
+
+	// written by the goroutine before done is closed.
+	err      error
+	closeErr error
+	canceled bool
+}
+
+func (s *PrefetchSequence[T]) Next() bool {
+	v, ok := <-s.ch
+	if !ok {
+		<-s.done
+		return false
+	}
+	s.value = v
+	return true
+}
+
+func (s *PrefetchSequence[T]) Value() T {
+	return s.value
+}
+
+// Err returns the error that ended the base, or ctx.Err() if ctx is done.
+func (s *PrefetchSequence[T]) Err() error {
+	select {
+	case <-s.done:
+		return s.err
+	default:
+		return nil
+	}
+}
+
+// Close stops the goroutine, waits for it to close the base and returns the error of closing it.
+// The cancellation caused by Close is not reported by Err.
+func (s *PrefetchSequence[T]) Close() error {
+	s.cancel(errClosed)
+	for range s.ch {
+	}
+	<-s.done
+	// an error the base ended with before Close is kept.
+	if s.canceled && errors.Is(context.Cause(s.ctx), errClosed) {
+		s.err = nil
+	}
+	return s.closeErr
+}
+
+// Prefetch returns a Sequence evaluating s in a new goroutine, up to n values ahead of the consumer.
+// The goroutine closes s once s ends, ctx is done or the returned Sequence is closed.
+// Close must be called when the returned Sequence is abandoned before its end, so that the goroutine stops.
+func Prefetch[T any](ctx context.Context, s Sequence[T], n int) Sequence[T] {
+	ctx, cancel := context.WithCancelCause(ctx)
+	ch := make(chan T, minmax.Max(n, 0))
+	done := make(chan struct{})
+	p := &PrefetchSequence[T]{ch: ch, done: done, ctx: ctx, cancel: cancel}
+	go func() {
+		defer close(done)
+		defer close(ch)
+		defer func() { p.closeErr = Close(s) }()
+		for s.Next() {
+			select {
+			case <-ctx.Done():
+				p.err, p.canceled = ctx.Err(), true
+				return
+			case ch <- s.Value():
+			}
+		}
+		p.err = Err(s)
+	}()
+	return p
+}
+
+type parallelJob[T, U any] struct {
+	value T
+	slot  chan<- U
+}
+
+// ParallelMapSequence applies a function to the values of its base on several goroutines,
+// while returning the results in the order of the base.
+type ParallelMapSequence[T, U any] struct {
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	slots  <-chan chan U
+	done   <-chan struct{}
+	value  U
+
+	// written by the goroutines before done is closed.
+	err      error
+	closeErr error
+	canceled bool
+	panicked bool
+	reason   any
+}
+
+func (s *ParallelMapSequence[T, U]) Next() bool {
+	slot, ok := <-s.slots
+	if !ok {
+		<-s.done
+		s.repanic()
+		return false
+	}
+	select {
+	case v := <-slot:
+		s.value = v
+		return true
+	case <-s.ctx.Done():
+		for range s.slots {
+		}
+		<-s.done
+		s.repanic()
+		// the base may have ended cleanly while results were still pending.
+		if s.err == nil {
+			s.err = s.ctx.Err()
+		}
+		return false
+	}
+}
+
+func (s *ParallelMapSequence[T, U]) repanic() {
+	if s.panicked {
+		panic(s.reason)
+	}
+}
+
+func (s *ParallelMapSequence[T, U]) Value() U {
+	return s.value
+}
+
+// Err returns the error that ended the base, or ctx.Err() if ctx is done.
+func (s *ParallelMapSequence[T, U]) Err() error {
+	select {
+	case <-s.done:
+		return s.err
+	default:
+		return nil
+	}
+}
+
+// Close stops the goroutines, waits for them to close the base and returns the error of closing it.
+// The cancellation caused by Close is not reported by Err.
+func (s *ParallelMapSequence[T, U]) Close() error {
+	s.cancel(errClosed)
+	for range s.slots {
+	}
+	<-s.done
+	// an error the base ended with before Close is kept.
+	if s.canceled && errors.Is(context.Cause(s.ctx), errClosed) {
+		s.err = nil
+	}
+	return s.closeErr
+}
+
+// ParallelMap is like Map but applies f on workers goroutines, evaluating the base ahead of the consumer.
+// The results keep the order of s, and at most about 2*workers values are in flight.
+// A panic in f is re-raised by Next of the returned Sequence.
+// Close must be called when the returned Sequence is abandoned before its end, so that the goroutines stop.
+// workers less than 1 is treated as 1.
+func ParallelMap[T, U any](ctx context.Context, s Sequence[T], f types.Function[T, U], workers int) Sequence[U] {
+	workers = minmax.Max(workers, 1)
+	ctx, cancel := context.WithCancelCause(ctx)
+	jobs := make(chan parallelJob[T, U])
+	slots := make(chan chan U, workers)
+	done := make(chan struct{})
+	p := &ParallelMapSequence[T, U]{ctx: ctx, cancel: cancel, slots: slots, done: done}
+
+	var (
+		wg   sync.WaitGroup
+		once sync.Once
+	)
+	wg.Add(1 + workers)
+	go func() {
+		defer wg.Done()
+		defer close(jobs)
+		defer close(slots)
+		defer func() { p.closeErr = Close(s) }()
+		for s.Next() {
+			slot := make(chan U, 1)
+			select {
+			case <-ctx.Done():
+				p.err, p.canceled = ctx.Err(), true
+				return
+			case slots <- slot:
+			}
+			select {
+			case <-ctx.Done():
+				p.err, p.canceled = ctx.Err(), true
+				return
+			case jobs <- parallelJob[T, U]{value: s.Value(), slot: slot}:
+			}
+		}
+		p.err = Err(s)
+	}()
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					once.Do(func() { p.panicked, p.reason = true, r })
+					cancel(nil)
+				}
+			}()
+			for j := range jobs {
+				j.slot <- f.Apply(j.value)
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	return p
+}
@@ -0,0 +1,167 @@
+package sequence_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Warashi/go-generics/sequence"
+	"github.com/Warashi/go-generics/types"
+)
+
+func TestPrefetch(t *testing.T) {
+	base := &naturals{}
+	s := sequence.Prefetch[int](context.Background(), sequence.Take[int](base, 100), 10)
+	if !s.Next() || s.Value() != 0 {
+		t.Fatalf("s.Value() = %v, want %v", s.Value(), 0)
+	}
+	got, err := sequence.TryCollect(s)
+	if err != nil {
+		t.Fatalf("err = %v", err)
+	}
+	if len(got) != 99 || got[0] != 1 || got[98] != 99 {
+		t.Errorf("rest = %v, want 1 to 99", got)
+	}
+
+	t.Run("error", func(t *testing.T) {
+		got, err := sequence.TryCollect(sequence.Prefetch[int](context.Background(), newBroken(1, 2), 1))
+		if !errors.Is(err, errBroken) {
+			t.Errorf("err = %v, want %v", err, errBroken)
+		}
+		if want := []int{1, 2}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+	t.Run("close", func(t *testing.T) {
+		r := &resource{}
+		s := sequence.Prefetch[int](context.Background(), newClosable(r, 1, 2, 3), 0)
+		s.Next()
+		if err := sequence.Close(s); err != nil {
+			t.Errorf("Close() = %v", err)
+		}
+		if r.closed != 1 {
+			t.Errorf("closed = %d, want 1", r.closed)
+		}
+		if err := sequence.Err(s); err != nil {
+			t.Errorf("Err() = %v after Close, want nil", err)
+		}
+	})
+	t.Run("close after error", func(t *testing.T) {
+		s := sequence.Prefetch[int](context.Background(), newBroken(1, 2), 1)
+		for s.Next() {
+		}
+		sequence.Close(s)
+		if err := sequence.Err(s); !errors.Is(err, errBroken) {
+			t.Errorf("Err() = %v after Close, want %v", err, errBroken)
+		}
+	})
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		s := sequence.Prefetch[int](ctx, &naturals{}, 0)
+		s.Next()
+		cancel()
+		for s.Next() {
+		}
+		sequence.Close(s)
+		if err := sequence.Err(s); !errors.Is(err, context.Canceled) {
+			t.Errorf("Err() = %v, want %v", err, context.Canceled)
+		}
+	})
+}
+
+func TestParallelMap(t *testing.T) {
+	var active, maxActive atomic.Int64
+	// the first two values wait for each other, which only returns if they are mapped concurrently.
+	var barrier sync.WaitGroup
+	barrier.Add(2)
+	slowSquare := types.Closure[int, int](func(v int) int {
+		if v < 2 {
+			barrier.Done()
+			barrier.Wait()
+		}
+		n := active.Add(1)
+		defer active.Add(-1)
+		for {
+			m := maxActive.Load()
+			if n <= m || maxActive.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		// later values finish first, so ordering is done by the Sequence.
+		time.Sleep(time.Duration(10-v%10) * time.Millisecond)
+		return v * v
+	})
+
+	got, err := sequence.TryCollect(sequence.ParallelMap[int, int](context.Background(), sequence.Range(0, 30), slowSquare, 4))
+	if err != nil {
+		t.Fatalf("err = %v", err)
+	}
+	want := make([]int, 30)
+	for i := range want {
+		want[i] = i * i
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if m := maxActive.Load(); m > 4 {
+		t.Errorf("%d mappers ran concurrently, want at most 4", m)
+	}
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		id := types.Identity[int]{}
+		s := sequence.ParallelMap[int, int](ctx, &naturals{}, id, 2)
+		s.Next()
+		cancel()
+		for s.Next() {
+		}
+		if err := sequence.Err(s); !errors.Is(err, context.Canceled) {
+			t.Errorf("Err() = %v, want %v", err, context.Canceled)
+		}
+	})
+	t.Run("close", func(t *testing.T) {
+		r := &resource{}
+		s := sequence.ParallelMap[int, int](context.Background(), newClosable(r, 1, 2, 3), types.Identity[int]{}, 2)
+		s.Next()
+		if err := sequence.Close(s); err != nil {
+			t.Errorf("Close() = %v", err)
+		}
+		if r.closed != 1 {
+			t.Errorf("closed = %d, want 1", r.closed)
+		}
+		if err := sequence.Err(s); err != nil {
+			t.Errorf("Err() = %v after Close, want nil", err)
+		}
+	})
+	t.Run("close after error", func(t *testing.T) {
+		s := sequence.ParallelMap[int, int](context.Background(), newBroken(1, 2), types.Identity[int]{}, 2)
+		for s.Next() {
+		}
+		sequence.Close(s)
+		if err := sequence.Err(s); !errors.Is(err, errBroken) {
+			t.Errorf("Err() = %v after Close, want %v", err, errBroken)
+		}
+	})
+	t.Run("panic", func(t *testing.T) {
+		boom := types.Closure[int, int](func(v int) int {
+			if v == 3 {
+				panic("boom")
+			}
+			return v
+		})
+		s := sequence.ParallelMap[int, int](context.Background(), sequence.Range(0, 10), boom, 2)
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("recover() = %v, want %v", r, "boom")
+			}
+		}()
+		for s.Next() {
+		}
+		t.Errorf("Next() returned false without panic")
+	})
+}
@@ -0,0 +1,138 @@
+package sequence
+
+import (
+	"errors"
+	"io"
+
+	"github.com/Warashi/go-generics/zero"
+)
+
+var (
+	_ Sequence[any] = (*PeekableSequence[any])(nil)
+	_ Errer         = (*PeekableSequence[any])(nil)
+	_ io.Closer     = (*PeekableSequence[any])(nil)
+
+	_ Sequence[any] = (*BufferedSequence[any])(nil)
+	_ Errer         = (*BufferedSequence[any])(nil)
+	_ io.Closer     = (*BufferedSequence[any])(nil)
+)
+
+// PeekableSequence adds one value of lookahead to a Sequence.
+type PeekableSequence[T any] struct {
+	base   Sequence[T]
+	value  T
+	next   T
+	peeked bool
+	ok     bool
+}
+
+// Peek returns the value the next call to Next will make current, without advancing.
+// It returns false if there is no such value.
+func (s *PeekableSequence[T]) Peek() (T, bool) {
+	if !s.peeked {
+		s.peeked = true
+		s.ok = s.base.Next()
+		if s.ok {
+			s.next = s.base.Value()
+		}
+	}
+	return s.next, s.ok
+}
+
+func (s *PeekableSequence[T]) Next() bool {
+	if s.peeked {
+		s.peeked = false
+		if !s.ok {
+			return false
+		}
+		s.value, s.next = s.next, zero.New[T]()
+		return true
+	}
+	if !s.base.Next() {
+		return false
+	}
+	s.value = s.base.Value()
+	return true
+}
+
+func (s *PeekableSequence[T]) Value() T {
+	return s.value
+}
+
+func (s *PeekableSequence[T]) Err() error {
+	return Err(s.base)
+}
+
+func (s *PeekableSequence[T]) Close() error {
+	return Close(s.base)
+}
+
+func Peekable[T any](s Sequence[T]) *PeekableSequence[T] {
+	return &PeekableSequence[T]{base: s}
+}
+
+// BufferedSequence can rewind to a marked position, like genio.SliceReader.UnreadElement does for one element.
+// The values read since the last Mark are kept in memory until the next Mark.
+type BufferedSequence[T any] struct {
+	base   Sequence[T]
+	buffer []T
+	cursor int
+	mark   int
+	marked bool
+}
+
+func (s *BufferedSequence[T]) Next() bool {
+	if s.cursor+1 < len(s.buffer) {
+		s.cursor++
+		return true
+	}
+	if !s.base.Next() {
+		return false
+	}
+	if s.marked {
+		s.buffer = append(s.buffer, s.base.Value())
+	} else {
+		s.buffer = append(s.buffer[:0], s.base.Value())
+	}
+	s.cursor = len(s.buffer) - 1
+	return true
+}
+
+func (s *BufferedSequence[T]) Value() T {
+	if s.cursor < 0 {
+		return zero.New[T]()
+	}
+	return s.buffer[s.cursor]
+}
+
+// Mark remembers the current position. A later Reset makes the current value the one at Mark again.
+// Values before the mark are released.
+func (s *BufferedSequence[T]) Mark() {
+	if s.cursor >= 0 {
+		s.buffer = append(s.buffer[:0], s.buffer[s.cursor:]...)
+		s.cursor = 0
+	}
+	s.mark = s.cursor
+	s.marked = true
+}
+
+// Reset rewinds to the last Mark. It returns an error if Mark has never been called.
+func (s *BufferedSequence[T]) Reset() error {
+	if !s.marked {
+		return errors.New("sequence.BufferedSequence.Reset: not marked")
+	}
+	s.cursor = s.mark
+	return nil
+}
+
+func (s *BufferedSequence[T]) Err() error {
+	return Err(s.base)
+}
+
+func (s *BufferedSequence[T]) Close() error {
+	return Close(s.base)
+}
+
+func Buffered[T any](s Sequence[T]) *BufferedSequence[T] {
+	return &BufferedSequence[T]{base: s, cursor: -1, mark: -1}
+}
@@ -0,0 +1,72 @@
+package sequence_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Warashi/go-generics/sequence"
+)
+
+func TestPeekable(t *testing.T) {
+	s := sequence.Peekable(sequence.Of(1, 2))
+
+	if v, ok := s.Peek(); !ok || v != 1 {
+		t.Errorf("s.Peek() = %v, %v, want %v, %v", v, ok, 1, true)
+	}
+	if v, ok := s.Peek(); !ok || v != 1 {
+		t.Errorf("second s.Peek() = %v, %v, want %v, %v", v, ok, 1, true)
+	}
+	if !s.Next() || s.Value() != 1 {
+		t.Errorf("s.Value() = %v, want %v", s.Value(), 1)
+	}
+	if !s.Next() || s.Value() != 2 {
+		t.Errorf("s.Value() = %v, want %v", s.Value(), 2)
+	}
+	if _, ok := s.Peek(); ok {
+		t.Errorf("s.Peek() at end = %v, want %v", ok, false)
+	}
+	if s.Next() {
+		t.Errorf("s.Next() at end = %v, want %v", true, false)
+	}
+	if s.Value() != 2 {
+		t.Errorf("s.Value() after end = %v, want %v", s.Value(), 2)
+	}
+}
+
+func TestBuffered(t *testing.T) {
+	s := sequence.Buffered(sequence.Of(1, 2, 3, 4))
+
+	if err := s.Reset(); err == nil {
+		t.Errorf("s.Reset() before Mark = %v, want error", err)
+	}
+
+	s.Next()
+	s.Mark()
+	s.Next()
+	s.Next()
+	if got := s.Value(); got != 3 {
+		t.Fatalf("s.Value() = %v, want %v", got, 3)
+	}
+	if err := s.Reset(); err != nil {
+		t.Fatalf("s.Reset() = %v", err)
+	}
+	if got := s.Value(); got != 1 {
+		t.Errorf("s.Value() after Reset = %v, want %v", got, 1)
+	}
+	if got, want := sequence.Collect[int](s), []int{2, 3, 4}; !cmp.Equal(got, want) {
+		t.Errorf("rest = %v, want %v", got, want)
+	}
+
+	t.Run("mark before first", func(t *testing.T) {
+		s := sequence.Buffered(sequence.Of(1, 2))
+		s.Mark()
+		first := sequence.Collect[int](s)
+		if err := s.Reset(); err != nil {
+			t.Fatalf("s.Reset() = %v", err)
+		}
+		if second := sequence.Collect[int](s); !cmp.Equal(first, second) {
+			t.Errorf("replay = %v, want %v", second, first)
+		}
+	})
+}
@@ -67,3 +67,35 @@ func Collect[T any](s Sequence[T]) []T {
 	ForEach(s, types.NewConsumer(func(value T) { v = append(v, value) }))
 	return v
 }
+func Reduce[T, A any](s Sequence[T], init A, f types.BiFunction[A, T, A]) A {
+	return monad.Reduce[T](MonadImpl[T, A]{}, s, init, f)
+}
+func FoldRight[T, A any](s Sequence[T], init A, f types.BiFunction[T, A, A]) A {
+	return MonadImpl[T, A]{}.FoldRight(s, init, f)
+}
+func Ap[F, T any](fs Sequence[types.Function[F, T]], from Sequence[F]) Sequence[T] {
+	return monad.Ap[Sequence[T], F, T](MonadImpl[F, T]{}, fs, from)
+}
+func Map2[A, B, C any](a Sequence[A], b Sequence[B], f types.BiFunction[A, B, C]) Sequence[C] {
+	return monad.Map2[Sequence[C]](MonadImpl[A, types.Function[B, C]]{}, MonadImpl[B, C]{}, a, b, f)
+}
+func Traverse[T, U any](from []T, f types.Function[T, Sequence[U]]) Sequence[[]U] {
+	return monad.Traverse[Sequence[[]U], T, U](MonadImpl[[]U, []U]{}, MonadImpl[U, []U]{}, from, f)
+}
+
+// SequenceA turns from into a Sequence of every combination of their values.
+// A Sequence can be consumed only once, so each of from is buffered on first use and replayed for every combination.
+func SequenceA[T any](from []Sequence[T]) Sequence[[]T] {
+	values := make([][]T, len(from))
+	buffered := make([]bool, len(from))
+	indices := make([]int, len(from))
+	for i := range indices {
+		indices[i] = i
+	}
+	return Traverse[int, T](indices, types.Closure[int, Sequence[T]](func(i int) Sequence[T] {
+		if !buffered[i] {
+			values[i], buffered[i] = Collect(from[i]), true
+		}
+		return Of(values[i]...)
+	}))
+}
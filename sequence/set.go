@@ -0,0 +1,55 @@
+package sequence
+
+import "github.com/Warashi/go-generics/types"
+
+// Distinct returns a Sequence of the values of s without duplicates, keeping the first occurrence of each.
+// It is lazy and remembers every value seen so far.
+func Distinct[T comparable](s Sequence[T]) Sequence[T] {
+	return DistinctBy[T, T](s, types.Identity[T]{})
+}
+
+// DistinctBy returns a Sequence of the values of s without duplicate keys, keeping the first occurrence of each.
+// It is lazy and remembers every key seen so far.
+func DistinctBy[T any, K comparable](s Sequence[T], key types.Function[T, K]) Sequence[T] {
+	seen := make(map[K]struct{})
+	return Filter[T](s, types.Closure[T, bool](func(v T) bool {
+		k := key.Apply(v)
+		if _, ok := seen[k]; ok {
+			return false
+		}
+		seen[k] = struct{}{}
+		return true
+	}))
+}
+
+// Union returns the distinct values of a followed by those of b not in a.
+func Union[T comparable](a, b Sequence[T]) Sequence[T] {
+	return Distinct(MonadImpl[T, T]{}.Plus(a, b))
+}
+
+// Intersect returns the distinct values of a that are also in b, in the order of a.
+// a is consumed lazily, while b is consumed entirely when the first value of a is tested.
+func Intersect[T comparable](a, b Sequence[T]) Sequence[T] {
+	contains := lazySet(b)
+	return Distinct(Filter[T](a, types.Closure[T, bool](contains)))
+}
+
+// Difference returns the distinct values of a that are not in b, in the order of a.
+// a is consumed lazily, while b is consumed entirely when the first value of a is tested.
+func Difference[T comparable](a, b Sequence[T]) Sequence[T] {
+	contains := lazySet(b)
+	return Distinct(Filter[T](a, types.Not[T](types.Closure[T, bool](contains))))
+}
+
+// lazySet returns a membership test for the values of s, collecting s on its first call.
+func lazySet[T comparable](s Sequence[T]) func(T) bool {
+	var set map[T]struct{}
+	return func(v T) bool {
+		if set == nil {
+			set = make(map[T]struct{})
+			ForEach(s, types.NewConsumer(func(v T) { set[v] = struct{}{} }))
+		}
+		_, ok := set[v]
+		return ok
+	}
+}
@@ -0,0 +1,47 @@
+package sequence_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/Warashi/go-generics/sequence"
+	"github.com/Warashi/go-generics/types"
+)
+
+func TestDistinct(t *testing.T) {
+	if got, want := sequence.Collect(sequence.Distinct(sequence.Of(1, 2, 1, 3, 2))), []int{1, 2, 3}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	t.Run("infinite", func(t *testing.T) {
+		mod3 := types.Closure[int, int](func(v int) int { return v % 3 })
+		got := sequence.Collect(sequence.Take(sequence.Distinct(sequence.Map[int, int](&naturals{}, mod3)), 3))
+		if want := []int{0, 1, 2}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+}
+
+func TestSetOperations(t *testing.T) {
+	of := func() (sequence.Sequence[int], sequence.Sequence[int]) {
+		return sequence.Of(1, 2, 2, 3), sequence.Of(3, 4, 1)
+	}
+	tests := []struct {
+		name string
+		op   func(a, b sequence.Sequence[int]) sequence.Sequence[int]
+		want []int
+	}{
+		{name: "Union", op: sequence.Union[int], want: []int{1, 2, 3, 4}},
+		{name: "Intersect", op: sequence.Intersect[int], want: []int{1, 3}},
+		{name: "Difference", op: sequence.Difference[int], want: []int{2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sequence.Collect(tt.op(of())); !cmp.Equal(got, tt.want, cmpopts.EquateEmpty()) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
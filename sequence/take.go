@@ -0,0 +1,216 @@
+package sequence
+
+import (
+	"io"
+
+	"github.com/Warashi/go-generics/types"
+)
+
+var (
+	_ Sequence[any] = (*TakeSequence[any])(nil)
+	_ Sequence[any] = (*DropSequence[any])(nil)
+	_ Sequence[any] = (*TakeWhileSequence[any])(nil)
+	_ Sequence[any] = (*DropWhileSequence[any])(nil)
+	_ Sequence[any] = (*StepSequence[any])(nil)
+
+	_ Errer = (*TakeSequence[any])(nil)
+	_ Errer = (*DropSequence[any])(nil)
+	_ Errer = (*TakeWhileSequence[any])(nil)
+	_ Errer = (*DropWhileSequence[any])(nil)
+	_ Errer = (*StepSequence[any])(nil)
+
+	_ io.Closer = (*TakeSequence[any])(nil)
+	_ io.Closer = (*DropSequence[any])(nil)
+	_ io.Closer = (*TakeWhileSequence[any])(nil)
+	_ io.Closer = (*DropWhileSequence[any])(nil)
+	_ io.Closer = (*StepSequence[any])(nil)
+)
+
+type TakeSequence[T any] struct {
+	base  Sequence[T]
+	n     int
+	taken int
+}
+
+func (s *TakeSequence[T]) Next() bool {
+	if s.taken >= s.n {
+		return false
+	}
+	if !s.base.Next() {
+		s.taken = s.n
+		return false
+	}
+	s.taken++
+	return true
+}
+
+func (s *TakeSequence[T]) Value() T {
+	return s.base.Value()
+}
+
+func (s *TakeSequence[T]) Err() error {
+	return Err(s.base)
+}
+
+func (s *TakeSequence[T]) Close() error {
+	return Close(s.base)
+}
+
+// Take returns a Sequence of the first n values of s.
+// It calls s.Next at most n times.
+func Take[T any](s Sequence[T], n int) Sequence[T] {
+	return &TakeSequence[T]{base: s, n: n}
+}
+
+// Limit is an alias of Take.
+func Limit[T any](s Sequence[T], n int) Sequence[T] {
+	return Take(s, n)
+}
+
+type DropSequence[T any] struct {
+	base    Sequence[T]
+	n       int
+	dropped bool
+}
+
+func (s *DropSequence[T]) Next() bool {
+	if !s.dropped {
+		s.dropped = true
+		for i := 0; i < s.n; i++ {
+			if !s.base.Next() {
+				return false
+			}
+		}
+	}
+	return s.base.Next()
+}
+
+func (s *DropSequence[T]) Value() T {
+	return s.base.Value()
+}
+
+func (s *DropSequence[T]) Err() error {
+	return Err(s.base)
+}
+
+func (s *DropSequence[T]) Close() error {
+	return Close(s.base)
+}
+
+// Drop returns a Sequence skipping the first n values of s.
+// The values are skipped on the first call to Next.
+func Drop[T any](s Sequence[T], n int) Sequence[T] {
+	return &DropSequence[T]{base: s, n: n}
+}
+
+// Skip is an alias of Drop.
+func Skip[T any](s Sequence[T], n int) Sequence[T] {
+	return Drop(s, n)
+}
+
+type TakeWhileSequence[T any] struct {
+	base     Sequence[T]
+	function types.Function[T, bool]
+	done     bool
+}
+
+func (s *TakeWhileSequence[T]) Next() bool {
+	if s.done {
+		return false
+	}
+	if !s.base.Next() || !s.function.Apply(s.base.Value()) {
+		s.done = true
+		return false
+	}
+	return true
+}
+
+func (s *TakeWhileSequence[T]) Value() T {
+	return s.base.Value()
+}
+
+func (s *TakeWhileSequence[T]) Err() error {
+	return Err(s.base)
+}
+
+func (s *TakeWhileSequence[T]) Close() error {
+	return Close(s.base)
+}
+
+// TakeWhile returns a Sequence of the values of s while f returns true.
+// The first value for which f returns false is consumed from s but not returned.
+func TakeWhile[T any](s Sequence[T], f types.Function[T, bool]) Sequence[T] {
+	return &TakeWhileSequence[T]{base: s, function: f}
+}
+
+type DropWhileSequence[T any] struct {
+	base     Sequence[T]
+	function types.Function[T, bool]
+	dropped  bool
+}
+
+func (s *DropWhileSequence[T]) Next() bool {
+	if !s.dropped {
+		s.dropped = true
+		for s.base.Next() {
+			if !s.function.Apply(s.base.Value()) {
+				return true
+			}
+		}
+		return false
+	}
+	return s.base.Next()
+}
+
+func (s *DropWhileSequence[T]) Value() T {
+	return s.base.Value()
+}
+
+func (s *DropWhileSequence[T]) Err() error {
+	return Err(s.base)
+}
+
+func (s *DropWhileSequence[T]) Close() error {
+	return Close(s.base)
+}
+
+// DropWhile returns a Sequence skipping the values of s while f returns true.
+func DropWhile[T any](s Sequence[T], f types.Function[T, bool]) Sequence[T] {
+	return &DropWhileSequence[T]{base: s, function: f}
+}
+
+type StepSequence[T any] struct {
+	base    Sequence[T]
+	n       int
+	started bool
+}
+
+func (s *StepSequence[T]) Next() bool {
+	if s.started {
+		for i := 1; i < s.n; i++ {
+			if !s.base.Next() {
+				return false
+			}
+		}
+	}
+	s.started = true
+	return s.base.Next()
+}
+
+func (s *StepSequence[T]) Value() T {
+	return s.base.Value()
+}
+
+func (s *StepSequence[T]) Err() error {
+	return Err(s.base)
+}
+
+func (s *StepSequence[T]) Close() error {
+	return Close(s.base)
+}
+
+// Step returns a Sequence of every n-th value of s, starting with the first one.
+// n less than 1 is treated as 1.
+func Step[T any](s Sequence[T], n int) Sequence[T] {
+	return &StepSequence[T]{base: s, n: n}
+}
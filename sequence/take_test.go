@@ -0,0 +1,121 @@
+package sequence_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/Warashi/go-generics/sequence"
+	"github.com/Warashi/go-generics/types"
+)
+
+// naturals is an infinite Sequence of 0, 1, 2, ... recording how many times Next is called.
+type naturals struct {
+	next int
+}
+
+func (s *naturals) Next() bool {
+	s.next++
+	return true
+}
+
+func (s *naturals) Value() int {
+	return s.next - 1
+}
+
+func TestTake(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		want     []int
+		wantNext int
+	}{
+		{name: "zero", n: 0, want: nil, wantNext: 0},
+		{name: "three", n: 3, want: []int{0, 1, 2}, wantNext: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base := &naturals{}
+			if got := sequence.Collect(sequence.Take[int](base, tt.n)); !cmp.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			if base.next != tt.wantNext {
+				t.Errorf("base.Next() called %d times, want %d", base.next, tt.wantNext)
+			}
+		})
+	}
+
+	t.Run("shorter base", func(t *testing.T) {
+		if got, want := sequence.Collect(sequence.Limit(sequence.Of(0, 1), 3)), []int{0, 1}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+}
+
+func TestDrop(t *testing.T) {
+	base := &naturals{}
+	s := sequence.Drop[int](base, 3)
+	if base.next != 0 {
+		t.Errorf("base.Next() called %d times before s.Next(), want %d", base.next, 0)
+	}
+	if got, want := sequence.Collect(sequence.Take(s, 2)), []int{3, 4}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	t.Run("longer than base", func(t *testing.T) {
+		if got := sequence.Collect(sequence.Skip(sequence.Of(0, 1), 3)); len(got) != 0 {
+			t.Errorf("got %v, want empty", got)
+		}
+	})
+}
+
+func TestTakeWhile(t *testing.T) {
+	base := &naturals{}
+	less3 := types.Closure[int, bool](func(v int) bool { return v < 3 })
+	if got, want := sequence.Collect(sequence.TakeWhile[int](base, less3)), []int{0, 1, 2}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if base.next != 4 {
+		t.Errorf("base.Next() called %d times, want %d", base.next, 4)
+	}
+}
+
+func TestDropWhile(t *testing.T) {
+	less3 := types.Closure[int, bool](func(v int) bool { return v < 3 })
+	got := sequence.Collect(sequence.DropWhile[int](sequence.Of(0, 1, 2, 3, 1), less3))
+	if want := []int{3, 1}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestStep(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		n    int
+		want []int
+	}{
+		{name: "step 2", in: []int{0, 1, 2, 3, 4}, n: 2, want: []int{0, 2, 4}},
+		{name: "step 3", in: []int{0, 1, 2, 3, 4}, n: 3, want: []int{0, 3}},
+		{name: "step 0", in: []int{0, 1}, n: 0, want: []int{0, 1}},
+		{name: "empty", in: nil, n: 2, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sequence.Collect(sequence.Step(sequence.Of(tt.in...), tt.n)); !cmp.Equal(got, tt.want, cmpopts.EquateEmpty()) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("infinite", func(t *testing.T) {
+		base := &naturals{}
+		if got, want := sequence.Collect(sequence.Take(sequence.Step[int](base, 10), 3)), []int{0, 10, 20}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+		if base.next != 21 {
+			t.Errorf("base.Next() called %d times, want %d", base.next, 21)
+		}
+	})
+}
@@ -0,0 +1,101 @@
+package sequence
+
+import (
+	"errors"
+	"io"
+
+	"github.com/Warashi/go-generics/types"
+	"github.com/Warashi/go-generics/zero"
+)
+
+var (
+	_ Sequence[any] = (*TryBindSequence[int, any])(nil)
+	_ Errer         = (*TryBindSequence[int, any])(nil)
+	_ io.Closer     = (*TryBindSequence[int, any])(nil)
+)
+
+// TryBindSequence is the fallible counterpart of BindSequence.
+// It ends at the first error returned by its function, which is then reported by Err like bufio.Scanner.
+type TryBindSequence[T, U any] struct {
+	base     Sequence[T]
+	current  Sequence[U]
+	function types.FallibleFunction[T, Sequence[U]]
+	err      error
+}
+
+func (s *TryBindSequence[T, U]) Next() bool {
+	for {
+		if s.err != nil {
+			return false
+		}
+		if s.current != nil {
+			if s.current.Next() {
+				return true
+			}
+			s.err = errors.Join(Err(s.current), Close(s.current))
+			s.current = nil
+			if s.err != nil {
+				return false
+			}
+		}
+		if !s.base.Next() {
+			return false
+		}
+		s.current, s.err = s.function.Apply(s.base.Value())
+	}
+}
+
+func (s *TryBindSequence[T, U]) Value() U {
+	if s.current == nil || s.err != nil {
+		return zero.New[U]()
+	}
+	return s.current.Value()
+}
+
+// Err returns the first error returned by the function or one of the Sequences, or nil if there was none.
+func (s *TryBindSequence[T, U]) Err() error {
+	if s.err != nil {
+		return s.err
+	}
+	return Err(s.base)
+}
+
+// Close closes the current inner Sequence and the base.
+func (s *TryBindSequence[T, U]) Close() error {
+	var err error
+	if s.current != nil {
+		err = Close(s.current)
+		s.current = nil
+	}
+	return errors.Join(err, Close(s.base))
+}
+
+func TryFlatMap[F, T any](from Sequence[F], f types.FallibleFunction[F, Sequence[T]]) *TryBindSequence[F, T] {
+	return &TryBindSequence[F, T]{
+		base:     from,
+		function: f,
+	}
+}
+
+func TryMap[F, T any](from Sequence[F], f types.FallibleFunction[F, T]) *TryBindSequence[F, T] {
+	return TryFlatMap[F, T](from, types.FallibleClosure[F, Sequence[T]](func(value F) (Sequence[T], error) {
+		v, err := f.Apply(value)
+		if err != nil {
+			return nil, err
+		}
+		return Of(v), nil
+	}))
+}
+
+func TryFilter[T any](from Sequence[T], f types.FallibleFunction[T, bool]) *TryBindSequence[T, T] {
+	return TryFlatMap[T, T](from, types.FallibleClosure[T, Sequence[T]](func(value T) (Sequence[T], error) {
+		ok, err := f.Apply(value)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return Of[T](), nil
+		}
+		return Of(value), nil
+	}))
+}
@@ -0,0 +1,118 @@
+package sequence_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Warashi/go-generics/sequence"
+	"github.com/Warashi/go-generics/types"
+)
+
+func TestTryMap(t *testing.T) {
+	atoi := types.FallibleClosure[string, int](strconv.Atoi)
+
+	t.Run("ok", func(t *testing.T) {
+		s := sequence.TryMap[string, int](sequence.Of("1", "2"), atoi)
+		if got, want := sequence.Collect[int](s), []int{1, 2}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+		if err := s.Err(); err != nil {
+			t.Errorf("s.Err() = %v, want nil", err)
+		}
+	})
+	t.Run("error", func(t *testing.T) {
+		s := sequence.TryMap[string, int](sequence.Of("1", "x", "2"), atoi)
+		if got, want := sequence.Collect[int](s), []int{1}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+		if err := s.Err(); err == nil {
+			t.Errorf("s.Err() = %v, want error", err)
+		}
+		if s.Next() {
+			t.Errorf("s.Next() = %v after error, want %v", true, false)
+		}
+	})
+}
+
+func TestTryFilter(t *testing.T) {
+	even := types.FallibleClosure[string, bool](func(s string) (bool, error) {
+		v, err := strconv.Atoi(s)
+		return v%2 == 0, err
+	})
+
+	s := sequence.TryFilter[string](sequence.Of("1", "2", "x", "4"), even)
+	if got, want := sequence.Collect[string](s), []string{"2"}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if err := s.Err(); err == nil {
+		t.Errorf("s.Err() = %v, want error", err)
+	}
+}
+
+// noLeak fails t if more goroutines are running at the end of the test than at the start.
+func noLeak(t *testing.T) {
+	t.Helper()
+	before := runtime.NumGoroutine()
+	t.Cleanup(func() {
+		deadline := time.Now().Add(time.Second)
+		for {
+			after := runtime.NumGoroutine()
+			if after <= before {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Errorf("goroutine leak: %d before, %d after", before, after)
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	})
+}
+
+func TestTryFlatMap_error(t *testing.T) {
+	errTry := errors.New("try")
+
+	t.Run("goroutine source", func(t *testing.T) {
+		noLeak(t)
+		s := sequence.TryMap[int, int](sequence.Prefetch[int](context.Background(), sequence.Range(0, 100), 0),
+			types.FallibleClosure[int, int](func(v int) (int, error) {
+				if v == 2 {
+					return 0, errTry
+				}
+				return v, nil
+			}))
+		if got, want := sequence.Collect[int](s), []int{0, 1}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+		if err := s.Err(); !errors.Is(err, errTry) {
+			t.Errorf("s.Err() = %v, want %v", err, errTry)
+		}
+		if err := s.Close(); err != nil {
+			t.Errorf("s.Close() = %v, want nil", err)
+		}
+	})
+	t.Run("resources", func(t *testing.T) {
+		var base, inner resource
+		s := sequence.TryFlatMap[int, int](newClosable(&base, 0, 1, 2),
+			types.FallibleClosure[int, sequence.Sequence[int]](func(v int) (sequence.Sequence[int], error) {
+				if v == 1 {
+					return nil, errTry
+				}
+				return newClosable(&inner, v), nil
+			}))
+		sequence.Collect[int](s)
+		if err := s.Err(); !errors.Is(err, errTry) {
+			t.Errorf("s.Err() = %v, want %v", err, errTry)
+		}
+		s.Close()
+		if base.closed != 1 || inner.closed != 1 {
+			t.Errorf("closed base %d and inner %d times, want 1 and 1", base.closed, inner.closed)
+		}
+	})
+}
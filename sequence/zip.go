@@ -0,0 +1,196 @@
+package sequence
+
+import (
+	"errors"
+	"io"
+
+	"github.com/Warashi/go-generics/container"
+	"github.com/Warashi/go-generics/optional"
+	"github.com/Warashi/go-generics/types"
+	"github.com/Warashi/go-generics/zero"
+)
+
+var (
+	_ Sequence[any]                                                           = (*ZipSequence[int, string, any])(nil)
+	_ Sequence[types.Pair[optional.Optional[int], optional.Optional[string]]] = (*ZipLongestSequence[int, string])(nil)
+
+	_ Sequence[any] = (*unzipSequence[any])(nil)
+	_ Errer         = (*unzipSequence[any])(nil)
+	_ io.Closer     = (*unzipSequence[any])(nil)
+)
+
+type ZipSequence[A, B, C any] struct {
+	base     types.Pair[Sequence[A], Sequence[B]]
+	function types.BiFunction[A, B, C]
+	value    C
+	done     bool
+}
+
+func (s *ZipSequence[A, B, C]) Next() bool {
+	if s.done {
+		return false
+	}
+	if !s.base.First.Next() || !s.base.Second.Next() {
+		s.done = true
+		s.value = zero.New[C]()
+		return false
+	}
+	s.value = s.function.Apply(s.base.First.Value(), s.base.Second.Value())
+	return true
+}
+
+func (s *ZipSequence[A, B, C]) Value() C {
+	return s.value
+}
+
+// Err returns the error that ended either of the zipped Sequences, if any.
+func (s *ZipSequence[A, B, C]) Err() error {
+	return errors.Join(Err(s.base.First), Err(s.base.Second))
+}
+
+// Close closes both zipped Sequences.
+func (s *ZipSequence[A, B, C]) Close() error {
+	return errors.Join(Close(s.base.First), Close(s.base.Second))
+}
+
+// ZipWith combines a and b element-wise with f and ends with the shorter one.
+// When b ends first, one extra value of a has been consumed.
+func ZipWith[A, B, C any](a Sequence[A], b Sequence[B], f types.BiFunction[A, B, C]) Sequence[C] {
+	return &ZipSequence[A, B, C]{
+		base:     types.NewPair(a, b),
+		function: f,
+	}
+}
+
+func Zip[A, B any](a Sequence[A], b Sequence[B]) Sequence[types.Pair[A, B]] {
+	return ZipWith[A, B, types.Pair[A, B]](a, b, types.BiClosure[A, B, types.Pair[A, B]](types.NewPair[A, B]))
+}
+
+type ZipLongestSequence[A, B any] struct {
+	base  types.Pair[Sequence[A], Sequence[B]]
+	value types.Pair[optional.Optional[A], optional.Optional[B]]
+	done  types.Pair[bool, bool]
+}
+
+func (s *ZipLongestSequence[A, B]) Next() bool {
+	s.value = types.Pair[optional.Optional[A], optional.Optional[B]]{}
+	if !s.done.First {
+		if s.base.First.Next() {
+			s.value.First = optional.New(s.base.First.Value())
+		} else {
+			s.done.First = true
+		}
+	}
+	if !s.done.Second {
+		if s.base.Second.Next() {
+			s.value.Second = optional.New(s.base.Second.Value())
+		} else {
+			s.done.Second = true
+		}
+	}
+	return !s.done.First || !s.done.Second
+}
+
+func (s *ZipLongestSequence[A, B]) Value() types.Pair[optional.Optional[A], optional.Optional[B]] {
+	return s.value
+}
+
+// Err returns the errors that ended either of the zipped Sequences, if any.
+func (s *ZipLongestSequence[A, B]) Err() error {
+	return errors.Join(Err(s.base.First), Err(s.base.Second))
+}
+
+// Close closes both zipped Sequences.
+func (s *ZipLongestSequence[A, B]) Close() error {
+	return errors.Join(Close(s.base.First), Close(s.base.Second))
+}
+
+// ZipLongest pairs a and b element-wise and ends with the longer one. Values missing from the shorter one are empty.
+func ZipLongest[A, B any](a Sequence[A], b Sequence[B]) Sequence[types.Pair[optional.Optional[A], optional.Optional[B]]] {
+	return &ZipLongestSequence[A, B]{base: types.NewPair(a, b)}
+}
+
+// unzipper splits a Sequence of pairs, buffering the values that one side has pulled but the other has not read yet.
+type unzipper[A, B any] struct {
+	base   Sequence[types.Pair[A, B]]
+	first  container.ArrayQueue[A]
+	second container.ArrayQueue[B]
+	// open counts the halves that are not closed yet.
+	open int
+}
+
+func (u *unzipper[A, B]) pull() bool {
+	if !u.base.Next() {
+		return false
+	}
+	a, b := u.base.Value().Values()
+	u.first.Add(a)
+	u.second.Add(b)
+	return true
+}
+
+// release closes the base once both halves are closed.
+func (u *unzipper[A, B]) release() error {
+	u.open--
+	if u.open > 0 {
+		return nil
+	}
+	return Close(u.base)
+}
+
+type unzipSequence[T any] struct {
+	pull    func() bool
+	err     func() error
+	release func() error
+	queue   *container.ArrayQueue[T]
+	value   T
+	closed  bool
+}
+
+func (s *unzipSequence[T]) Next() bool {
+	if s.closed || s.queue.Size() == 0 && !s.pull() {
+		return false
+	}
+	v, err := s.queue.Remove()
+	if err != nil {
+		panic(err)
+	}
+	s.value = v
+	return true
+}
+
+func (s *unzipSequence[T]) Value() T {
+	return s.value
+}
+
+func (s *unzipSequence[T]) Err() error {
+	return s.err()
+}
+
+// Close closes the base once the other half is closed too. Closing a half more than once has no further effect.
+func (s *unzipSequence[T]) Close() error {
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	return s.release()
+}
+
+// Unzip splits s into two Sequences. Both can be consumed independently;
+// values read by only one of them are buffered for the other.
+// s is closed once both of them are closed.
+func Unzip[A, B any](s Sequence[types.Pair[A, B]]) (Sequence[A], Sequence[B]) {
+	u := &unzipper[A, B]{base: s, open: 2}
+	err := func() error { return Err(u.base) }
+	return &unzipSequence[A]{pull: u.pull, err: err, release: u.release, queue: &u.first},
+		&unzipSequence[B]{pull: u.pull, err: err, release: u.release, queue: &u.second}
+}
+
+// Enumerate pairs each value of s with its index, counting from 0.
+func Enumerate[T any](s Sequence[T]) Sequence[types.Pair[int, T]] {
+	i := -1
+	return Map[T, types.Pair[int, T]](s, types.Closure[T, types.Pair[int, T]](func(v T) types.Pair[int, T] {
+		i++
+		return types.NewPair(i, v)
+	}))
+}
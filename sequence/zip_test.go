@@ -0,0 +1,75 @@
+package sequence_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Warashi/go-generics/optional"
+	"github.com/Warashi/go-generics/sequence"
+	"github.com/Warashi/go-generics/types"
+)
+
+func TestZip(t *testing.T) {
+	got := sequence.Collect(sequence.Zip[int, string](&naturals{}, sequence.Of("a", "b")))
+	if want := []types.Pair[int, string]{types.NewPair(0, "a"), types.NewPair(1, "b")}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestZipWith(t *testing.T) {
+	add := types.BiClosure[int, int, int](func(a, b int) int { return a + b })
+	got := sequence.Collect(sequence.ZipWith[int, int, int](sequence.Of(1, 2), sequence.Of(10, 20, 30), add))
+	if want := []int{11, 22}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	t.Run("function is applied once per value", func(t *testing.T) {
+		calls := 0
+		counted := types.BiClosure[int, int, int](func(a, b int) int {
+			calls++
+			return a + b
+		})
+		s := sequence.ZipWith[int, int, int](sequence.Of(1, 2), sequence.Of(10, 20), counted)
+		for s.Next() {
+			s.Value()
+			s.Value()
+		}
+		if calls != 2 {
+			t.Errorf("function called %d times, want %d", calls, 2)
+		}
+	})
+}
+
+func TestZipLongest(t *testing.T) {
+	got := sequence.Collect(sequence.ZipLongest(sequence.Of(1), sequence.Of("a", "b")))
+	want := []types.Pair[optional.Optional[int], optional.Optional[string]]{
+		types.NewPair(optional.New(1), optional.New("a")),
+		types.NewPair(optional.Empty[int](), optional.New("b")),
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	a, b := sequence.Unzip(sequence.Of(types.NewPair(1, "a"), types.NewPair(2, "b"), types.NewPair(3, "c")))
+
+	// read b ahead of a to exercise buffering.
+	if !b.Next() || !b.Next() || b.Value() != "b" {
+		t.Fatalf("b.Value() = %v, want %v", b.Value(), "b")
+	}
+	if got, want := sequence.Collect(a), []int{1, 2, 3}; !cmp.Equal(got, want) {
+		t.Errorf("a = %v, want %v", got, want)
+	}
+	if got, want := sequence.Collect(b), []string{"c"}; !cmp.Equal(got, want) {
+		t.Errorf("rest of b = %v, want %v", got, want)
+	}
+}
+
+func TestEnumerate(t *testing.T) {
+	got := sequence.Collect(sequence.Enumerate(sequence.Of("a", "b")))
+	if want := []types.Pair[int, string]{types.NewPair(0, "a"), types.NewPair(1, "b")}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
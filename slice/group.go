@@ -0,0 +1,65 @@
+package slice
+
+import (
+	"github.com/Warashi/go-generics/minmax"
+	"github.com/Warashi/go-generics/types"
+)
+
+// Chunk splits from into consecutive slices of n elements. The last chunk may be shorter.
+// The chunks share the backing array of from. n less than 1 is treated as 1.
+func Chunk[T any](from []T, n int) [][]T {
+	n = minmax.Max(n, 1)
+	result := make([][]T, 0, (len(from)+n-1)/n)
+	for i := 0; i < len(from); i += n {
+		end := minmax.Min(i+n, len(from))
+		result = append(result, from[i:end:end])
+	}
+	return result
+}
+
+// Window returns the sliding windows of size elements of from, moving by step elements each time.
+// Only full windows are returned and they share the backing array of from. size and step less than 1 are treated as 1.
+func Window[T any](from []T, size, step int) [][]T {
+	size, step = minmax.Max(size, 1), minmax.Max(step, 1)
+	var result [][]T
+	for i := 0; i+size <= len(from); i += step {
+		result = append(result, from[i:i+size:i+size])
+	}
+	return result
+}
+
+// ChunkBy splits from into runs of consecutive elements having the same key.
+func ChunkBy[T any, K comparable](from []T, key types.Function[T, K]) [][]T {
+	var result [][]T
+	start := 0
+	for i := 1; i <= len(from); i++ {
+		if i == len(from) || key.Apply(from[start]) != key.Apply(from[i]) {
+			result = append(result, from[start:i:i])
+			start = i
+		}
+	}
+	return result
+}
+
+// GroupBy groups the elements of from by key, preserving their order within each group.
+func GroupBy[T any, K comparable](from []T, key types.Function[T, K]) map[K][]T {
+	groups := make(map[K][]T)
+	for _, v := range from {
+		k := key.Apply(v)
+		groups[k] = append(groups[k], v)
+	}
+	return groups
+}
+
+// Partition splits from into the elements for which f returns true and the others.
+func Partition[T any](from []T, f types.Function[T, bool]) ([]T, []T) {
+	var matched, unmatched []T
+	for _, v := range from {
+		if f.Apply(v) {
+			matched = append(matched, v)
+		} else {
+			unmatched = append(unmatched, v)
+		}
+	}
+	return matched, unmatched
+}
@@ -0,0 +1,86 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/Warashi/go-generics/slice"
+	"github.com/Warashi/go-generics/types"
+)
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		n    int
+		want [][]int
+	}{
+		{name: "even", in: []int{0, 1, 2, 3}, n: 2, want: [][]int{{0, 1}, {2, 3}}},
+		{name: "remainder", in: []int{0, 1, 2}, n: 2, want: [][]int{{0, 1}, {2}}},
+		{name: "zero", in: []int{0, 1}, n: 0, want: [][]int{{0}, {1}}},
+		{name: "empty", in: nil, n: 2, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slice.Chunk(tt.in, tt.n); !cmp.Equal(got, tt.want, cmpopts.EquateEmpty()) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("append does not overwrite", func(t *testing.T) {
+		in := []int{0, 1, 2, 3}
+		chunks := slice.Chunk(in, 2)
+		_ = append(chunks[0], 9)
+		if in[2] != 2 {
+			t.Errorf("in[2] = %v, want %v", in[2], 2)
+		}
+	})
+}
+
+func TestWindow(t *testing.T) {
+	tests := []struct {
+		name       string
+		in         []int
+		size, step int
+		want       [][]int
+	}{
+		{name: "sliding", in: []int{0, 1, 2, 3}, size: 2, step: 1, want: [][]int{{0, 1}, {1, 2}, {2, 3}}},
+		{name: "hopping", in: []int{0, 1, 2, 3, 4, 5, 6}, size: 2, step: 3, want: [][]int{{0, 1}, {3, 4}}},
+		{name: "too short", in: []int{0}, size: 2, step: 1, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slice.Window(tt.in, tt.size, tt.step); !cmp.Equal(got, tt.want, cmpopts.EquateEmpty()) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChunkBy(t *testing.T) {
+	parity := types.Closure[int, int](func(v int) int { return v % 2 })
+	if got, want := slice.ChunkBy[int, int]([]int{1, 3, 2, 4, 5}, parity), [][]int{{1, 3}, {2, 4}, {5}}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	parity := types.Closure[int, int](func(v int) int { return v % 2 })
+	if got, want := slice.GroupBy[int, int]([]int{1, 3, 2, 4, 5}, parity), map[int][]int{0: {2, 4}, 1: {1, 3, 5}}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	even := types.Closure[int, bool](func(v int) bool { return v%2 == 0 })
+	evens, odds := slice.Partition[int]([]int{0, 1, 2, 3}, even)
+	if want := []int{0, 2}; !cmp.Equal(evens, want) {
+		t.Errorf("evens = %v, want %v", evens, want)
+	}
+	if want := []int{1, 3}; !cmp.Equal(odds, want) {
+		t.Errorf("odds = %v, want %v", odds, want)
+	}
+}
@@ -4,8 +4,10 @@ import "github.com/Warashi/go-generics/monad"
 import "github.com/Warashi/go-generics/types"
 
 var (
-	_ monad.Monad[int, string, []int, []string]         = MonadImpl[int, string]{}
-	_ monad.AdditiveMonad[int, string, []int, []string] = MonadImpl[int, string]{}
+	_ monad.Monad[int, string, []int, []string]                                      = MonadImpl[int, string]{}
+	_ monad.AdditiveMonad[int, string, []int, []string]                              = MonadImpl[int, string]{}
+	_ monad.Foldable[int, string, []int]                                             = MonadImpl[int, string]{}
+	_ monad.Applicative[int, string, []int, []string, []types.Function[int, string]] = MonadImpl[int, string]{}
 )
 
 type MonadImpl[T, U any] struct{}
@@ -32,3 +34,29 @@ func (MonadImpl[T, U]) Plus(a, b []T) []T {
 	copy(r[len(a):], b)
 	return r
 }
+
+func (MonadImpl[T, U]) FoldLeft(src []T, init U, f types.BiFunction[U, T, U]) U {
+	acc := init
+	for _, v := range src {
+		acc = f.Apply(acc, v)
+	}
+	return acc
+}
+
+func (MonadImpl[T, U]) FoldRight(src []T, init U, f types.BiFunction[T, U, U]) U {
+	acc := init
+	for i := len(src) - 1; i >= 0; i-- {
+		acc = f.Apply(src[i], acc)
+	}
+	return acc
+}
+
+func (MonadImpl[T, U]) Ap(fs []types.Function[T, U], src []T) []U {
+	result := make([]U, 0, len(fs)*len(src))
+	for _, f := range fs {
+		for _, v := range src {
+			result = append(result, f.Apply(v))
+		}
+	}
+	return result
+}
@@ -0,0 +1,40 @@
+package slice_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/Warashi/go-generics/monad/monadtest"
+	"github.com/Warashi/go-generics/slice"
+	"github.com/Warashi/go-generics/types"
+)
+
+func TestMonadLaws(t *testing.T) {
+	values := func(r *rand.Rand) []int {
+		v := make([]int, r.Intn(4))
+		for i := range v {
+			v[i] = r.Intn(10)
+		}
+		return v
+	}
+	gen := monadtest.Generator[int, []int]{
+		Value: func(r *rand.Rand) int { return r.Intn(10) },
+		Monad: values,
+		Function: func(r *rand.Rand) types.Function[int, []int] {
+			k := r.Intn(5)
+			return types.Closure[int, []int](func(x int) []int {
+				v := make([]int, (x+k)%3)
+				for i := range v {
+					v[i] = x + k + i
+				}
+				return v
+			})
+		},
+	}
+	equal := func(a, b []int) bool { return cmp.Equal(a, b, cmpopts.EquateEmpty()) }
+
+	monadtest.TestAdditiveMonad[int, []int](t, slice.MonadImpl[int, int]{}, gen, equal)
+}
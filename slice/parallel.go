@@ -0,0 +1,102 @@
+package slice
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	"github.com/Warashi/go-generics/minmax"
+	"github.com/Warashi/go-generics/types"
+)
+
+// parallel calls f(i) for i in [0, n) on at most limit goroutines.
+// It stops handing out indices once ctx is done, in which case it returns ctx.Err().
+// It returns nil if every index was handed out, even if ctx is done by then.
+// A panic in f stops the remaining work and is re-raised in the calling goroutine.
+func parallel(ctx context.Context, n, limit int, f func(i int)) error {
+	if limit < 1 {
+		limit = runtime.GOMAXPROCS(0)
+	}
+	workerCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		panicked bool
+		reason   any
+	)
+	indices := make(chan int)
+	for w := 0; w < minmax.Min(limit, n); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					once.Do(func() { panicked, reason = true, r })
+					cancel()
+				}
+			}()
+			for i := range indices {
+				f(i)
+			}
+		}()
+	}
+
+	stopped := false
+feed:
+	for i := 0; i < n; i++ {
+		select {
+		case <-workerCtx.Done():
+			stopped = true
+			break feed
+		case indices <- i:
+		}
+	}
+	close(indices)
+	wg.Wait()
+
+	if panicked {
+		panic(reason)
+	}
+	// every index handed out has been processed, so a late cancellation does not matter.
+	if !stopped {
+		return nil
+	}
+	return ctx.Err()
+}
+
+// ParallelMap is like Map but applies f on at most limit goroutines. The result keeps the order of from.
+// limit less than 1 means runtime.GOMAXPROCS(0).
+// When ctx is done before every element is mapped, it returns nil and ctx.Err().
+func ParallelMap[F, T any](ctx context.Context, from []F, f types.Function[F, T], limit int) ([]T, error) {
+	result := make([]T, len(from))
+	if err := parallel(ctx, len(from), limit, func(i int) { result[i] = f.Apply(from[i]) }); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ParallelFilter is like Filter but applies f on at most limit goroutines. The result keeps the order of from.
+// limit less than 1 means runtime.GOMAXPROCS(0).
+// When ctx is done before every element is tested, it returns nil and ctx.Err().
+func ParallelFilter[T any](ctx context.Context, from []T, f types.Function[T, bool], limit int) ([]T, error) {
+	keep := make([]bool, len(from))
+	if err := parallel(ctx, len(from), limit, func(i int) { keep[i] = f.Apply(from[i]) }); err != nil {
+		return nil, err
+	}
+	var result []T
+	for i, v := range from {
+		if keep[i] {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+// ParallelForEach is like ForEach but calls f on at most limit goroutines, in no particular order.
+// limit less than 1 means runtime.GOMAXPROCS(0).
+// When ctx is done before every element is visited, it returns ctx.Err().
+func ParallelForEach[T any](ctx context.Context, from []T, f types.Consumer[T], limit int) error {
+	return parallel(ctx, len(from), limit, func(i int) { f.Accept(from[i]) })
+}
@@ -0,0 +1,126 @@
+package slice_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Warashi/go-generics/slice"
+	"github.com/Warashi/go-generics/types"
+)
+
+func TestParallelMap(t *testing.T) {
+	in := make([]int, 100)
+	for i := range in {
+		in[i] = i
+	}
+
+	var active, maxActive atomic.Int64
+	square := types.Closure[int, int](func(v int) int {
+		n := active.Add(1)
+		defer active.Add(-1)
+		for {
+			m := maxActive.Load()
+			if n <= m || maxActive.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		return v * v
+	})
+
+	got, err := slice.ParallelMap[int, int](context.Background(), in, square, 4)
+	if err != nil {
+		t.Fatalf("ParallelMap() error = %v", err)
+	}
+	want := make([]int, len(in))
+	for i := range want {
+		want[i] = i * i
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if m := maxActive.Load(); m > 4 {
+		t.Errorf("%d mappers ran concurrently, want at most %d", m, 4)
+	}
+}
+
+func TestParallelFilter(t *testing.T) {
+	even := types.Closure[int, bool](func(v int) bool { return v%2 == 0 })
+	got, err := slice.ParallelFilter[int](context.Background(), []int{0, 1, 2, 3, 4}, even, 2)
+	if err != nil {
+		t.Fatalf("ParallelFilter() error = %v", err)
+	}
+	if want := []int{0, 2, 4}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestParallelForEach(t *testing.T) {
+	var sum atomic.Int64
+	add := types.NewConsumer(func(v int) { sum.Add(int64(v)) })
+	if err := slice.ParallelForEach(context.Background(), []int{1, 2, 3, 4}, add, 0); err != nil {
+		t.Fatalf("ParallelForEach() error = %v", err)
+	}
+	if got := sum.Load(); got != 10 {
+		t.Errorf("sum = %v, want %v", got, 10)
+	}
+}
+
+func TestParallelCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls atomic.Int64
+	f := types.NewConsumer(func(v int) {
+		if calls.Add(1) == 3 {
+			cancel()
+		}
+	})
+
+	err := slice.ParallelForEach(ctx, make([]int, 1000), f, 1)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ParallelForEach() error = %v, want %v", err, context.Canceled)
+	}
+	if n := calls.Load(); n >= 1000 {
+		t.Errorf("f called %d times after cancel, want early stop", n)
+	}
+}
+
+func TestParallelCancelAfterLast(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// the last value is handed out before f cancels ctx, so the result is complete.
+	f := types.Closure[int, int](func(v int) int {
+		if v == 2 {
+			cancel()
+		}
+		return v * 10
+	})
+	got, err := slice.ParallelMap(ctx, []int{0, 1, 2}, f, 1)
+	if err != nil {
+		t.Fatalf("ParallelMap() error = %v, want nil", err)
+	}
+	if want := []int{0, 10, 20}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestParallelPanic(t *testing.T) {
+	boom := types.Closure[int, int](func(v int) int {
+		if v == 5 {
+			panic("boom")
+		}
+		return v
+	})
+
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("recover() = %v, want %v", r, "boom")
+		}
+	}()
+	slice.ParallelMap[int, int](context.Background(), []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, boom, 3)
+	t.Errorf("ParallelMap() returned without panic")
+}
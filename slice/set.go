@@ -0,0 +1,54 @@
+package slice
+
+import "github.com/Warashi/go-generics/types"
+
+// Distinct returns the elements of from without duplicates, keeping the first occurrence of each.
+func Distinct[T comparable](from []T) []T {
+	return DistinctBy[T, T](from, types.Identity[T]{})
+}
+
+// DistinctBy returns the elements of from without duplicate keys, keeping the first occurrence of each.
+func DistinctBy[T any, K comparable](from []T, key types.Function[T, K]) []T {
+	seen := make(map[K]struct{}, len(from))
+	var result []T
+	for _, v := range from {
+		k := key.Apply(v)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		result = append(result, v)
+	}
+	return result
+}
+
+// Union returns the distinct elements of a followed by those of b not in a.
+func Union[T comparable](a, b []T) []T {
+	return Distinct(MonadImpl[T, T]{}.Plus(a, b))
+}
+
+// Intersect returns the distinct elements of a that are also in b, in the order of a.
+func Intersect[T comparable](a, b []T) []T {
+	set := toSet(b)
+	return Distinct(Filter[T](a, types.Closure[T, bool](func(v T) bool {
+		_, ok := set[v]
+		return ok
+	})))
+}
+
+// Difference returns the distinct elements of a that are not in b, in the order of a.
+func Difference[T comparable](a, b []T) []T {
+	set := toSet(b)
+	return Distinct(Filter[T](a, types.Closure[T, bool](func(v T) bool {
+		_, ok := set[v]
+		return !ok
+	})))
+}
+
+func toSet[T comparable](from []T) map[T]struct{} {
+	set := make(map[T]struct{}, len(from))
+	for _, v := range from {
+		set[v] = struct{}{}
+	}
+	return set
+}
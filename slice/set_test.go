@@ -0,0 +1,43 @@
+package slice_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/Warashi/go-generics/slice"
+	"github.com/Warashi/go-generics/types"
+)
+
+func TestDistinct(t *testing.T) {
+	if got, want := slice.Distinct([]int{1, 2, 1, 3, 2}), []int{1, 2, 3}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	lower := types.Closure[string, string](strings.ToLower)
+	if got, want := slice.DistinctBy[string, string]([]string{"a", "A", "b"}, lower), []string{"a", "b"}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	a, b := []int{1, 2, 2, 3}, []int{3, 4, 1}
+	tests := []struct {
+		name string
+		got  []int
+		want []int
+	}{
+		{name: "Union", got: slice.Union(a, b), want: []int{1, 2, 3, 4}},
+		{name: "Intersect", got: slice.Intersect(a, b), want: []int{1, 3}},
+		{name: "Difference", got: slice.Difference(a, b), want: []int{2}},
+		{name: "Difference empty", got: slice.Difference(a, a), want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !cmp.Equal(tt.got, tt.want, cmpopts.EquateEmpty()) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
@@ -15,3 +15,21 @@ func Filter[T any](from []T, f types.Function[T, bool]) []T {
 func ForEach[T any](from []T, f types.Consumer[T]) {
 	monad.Do[types.Void, []types.Void](MonadImpl[T, types.Void]{}, from, f)
 }
+func Reduce[T, A any](from []T, init A, f types.BiFunction[A, T, A]) A {
+	return monad.Reduce[T](MonadImpl[T, A]{}, from, init, f)
+}
+func FoldRight[T, A any](from []T, init A, f types.BiFunction[T, A, A]) A {
+	return MonadImpl[T, A]{}.FoldRight(from, init, f)
+}
+func Ap[F, T any](fs []types.Function[F, T], from []F) []T {
+	return monad.Ap[[]T, F, T](MonadImpl[F, T]{}, fs, from)
+}
+func Map2[A, B, C any](a []A, b []B, f types.BiFunction[A, B, C]) []C {
+	return monad.Map2[[]C](MonadImpl[A, types.Function[B, C]]{}, MonadImpl[B, C]{}, a, b, f)
+}
+func Traverse[T, U any](from []T, f types.Function[T, []U]) [][]U {
+	return monad.Traverse[[][]U, T, U](MonadImpl[[]U, []U]{}, MonadImpl[U, []U]{}, from, f)
+}
+func SequenceA[T any](from [][]T) [][]T {
+	return monad.SequenceA[[][]T, T](MonadImpl[[]T, []T]{}, MonadImpl[T, []T]{}, from)
+}
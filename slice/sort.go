@@ -0,0 +1,44 @@
+package slice
+
+import (
+	"slices"
+
+	"github.com/Warashi/go-generics/types"
+)
+
+// Rand is the source of randomness for Shuffle. *rand.Rand of math/rand satisfies it.
+type Rand interface {
+	Intn(n int) int
+}
+
+// SortBy returns a sorted copy of from. compare returns a negative number when a < b,
+// a positive number when a > b and zero otherwise, like cmp.Compare.
+func SortBy[T any](from []T, compare types.BiFunction[T, T, int]) []T {
+	result := slices.Clone(from)
+	slices.SortFunc(result, compare.Apply)
+	return result
+}
+
+// SortStableBy is like SortBy but keeps the original order of equal elements.
+func SortStableBy[T any](from []T, compare types.BiFunction[T, T, int]) []T {
+	result := slices.Clone(from)
+	slices.SortStableFunc(result, compare.Apply)
+	return result
+}
+
+// Reverse returns a reversed copy of from.
+func Reverse[T any](from []T) []T {
+	result := slices.Clone(from)
+	slices.Reverse(result)
+	return result
+}
+
+// Shuffle returns a copy of from shuffled with r, so that the order is reproducible for a seeded r.
+func Shuffle[T any](from []T, r Rand) []T {
+	result := slices.Clone(from)
+	for i := len(result) - 1; i > 0; i-- {
+		j := r.Intn(i + 1)
+		result[i], result[j] = result[j], result[i]
+	}
+	return result
+}
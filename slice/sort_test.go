@@ -0,0 +1,58 @@
+package slice_test
+
+import (
+	"cmp"
+	"math/rand"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/Warashi/go-generics/slice"
+	"github.com/Warashi/go-generics/types"
+)
+
+func TestSortBy(t *testing.T) {
+	in := []int{3, 1, 2}
+	got := slice.SortBy(in, types.BiClosure[int, int, int](cmp.Compare[int]))
+	if want := []int{1, 2, 3}; !gocmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if want := []int{3, 1, 2}; !gocmp.Equal(in, want) {
+		t.Errorf("input modified to %v, want %v", in, want)
+	}
+}
+
+func TestSortStableBy(t *testing.T) {
+	type item struct {
+		key  int
+		name string
+	}
+	in := []item{{1, "a"}, {0, "b"}, {1, "c"}, {0, "d"}}
+	got := slice.SortStableBy(in, types.BiClosure[item, item, int](func(a, b item) int { return cmp.Compare(a.key, b.key) }))
+	want := []item{{0, "b"}, {0, "d"}, {1, "a"}, {1, "c"}}
+	if !gocmp.Equal(got, want, gocmp.AllowUnexported(item{})) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestReverse(t *testing.T) {
+	if got, want := slice.Reverse([]int{1, 2, 3}), []int{3, 2, 1}; !gocmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestShuffle(t *testing.T) {
+	in := []int{0, 1, 2, 3, 4, 5, 6, 7}
+	a := slice.Shuffle(in, rand.New(rand.NewSource(1)))
+	b := slice.Shuffle(in, rand.New(rand.NewSource(1)))
+	if !gocmp.Equal(a, b) {
+		t.Errorf("same seed gave %v and %v", a, b)
+	}
+	if !gocmp.Equal(a, in, cmpopts.SortSlices(func(x, y int) bool { return x < y })) {
+		t.Errorf("got %v, want a permutation of %v", a, in)
+	}
+	if want := []int{0, 1, 2, 3, 4, 5, 6, 7}; !gocmp.Equal(in, want) {
+		t.Errorf("input modified to %v, want %v", in, want)
+	}
+}
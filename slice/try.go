@@ -0,0 +1,44 @@
+package slice
+
+import "github.com/Warashi/go-generics/types"
+
+// TryMap applies f to each element of from and stops at the first error.
+func TryMap[F, T any](from []F, f types.FallibleFunction[F, T]) ([]T, error) {
+	result := make([]T, 0, len(from))
+	for _, v := range from {
+		vv, err := f.Apply(v)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, vv)
+	}
+	return result, nil
+}
+
+// TryFlatMap applies f to each element of from, concatenates the results and stops at the first error.
+func TryFlatMap[F, T any](from []F, f types.FallibleFunction[F, []T]) ([]T, error) {
+	var result []T
+	for _, v := range from {
+		vv, err := f.Apply(v)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, vv...)
+	}
+	return result, nil
+}
+
+// TryFilter keeps the elements of from for which f returns true and stops at the first error.
+func TryFilter[T any](from []T, f types.FallibleFunction[T, bool]) ([]T, error) {
+	var result []T
+	for _, v := range from {
+		ok, err := f.Apply(v)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
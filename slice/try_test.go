@@ -0,0 +1,68 @@
+package slice_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Warashi/go-generics/slice"
+	"github.com/Warashi/go-generics/types"
+)
+
+func TestTryMap(t *testing.T) {
+	atoi := types.FallibleClosure[string, int](strconv.Atoi)
+	tests := []struct {
+		name    string
+		in      []string
+		want    []int
+		wantErr bool
+	}{
+		{name: "ok", in: []string{"1", "2"}, want: []int{1, 2}},
+		{name: "error", in: []string{"1", "x", "2"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := slice.TryMap[string, int](tt.in, atoi)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TryMap() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("TryMap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTryFilter(t *testing.T) {
+	even := types.FallibleClosure[string, bool](func(s string) (bool, error) {
+		v, err := strconv.Atoi(s)
+		return v%2 == 0, err
+	})
+
+	got, err := slice.TryFilter[string]([]string{"1", "2", "4"}, even)
+	if err != nil {
+		t.Fatalf("TryFilter() error = %v", err)
+	}
+	if want := []string{"2", "4"}; !cmp.Equal(got, want) {
+		t.Errorf("TryFilter() = %v, want %v", got, want)
+	}
+	if _, err := slice.TryFilter[string]([]string{"1", "x"}, even); err == nil {
+		t.Errorf("TryFilter() error = %v, wantErr %v", err, true)
+	}
+}
+
+func TestTryFlatMap(t *testing.T) {
+	repeat := types.FallibleClosure[string, []int](func(s string) ([]int, error) {
+		v, err := strconv.Atoi(s)
+		return []int{v, v}, err
+	})
+
+	got, err := slice.TryFlatMap[string, int]([]string{"1", "2"}, repeat)
+	if err != nil {
+		t.Fatalf("TryFlatMap() error = %v", err)
+	}
+	if want := []int{1, 1, 2, 2}; !cmp.Equal(got, want) {
+		t.Errorf("TryFlatMap() = %v, want %v", got, want)
+	}
+}
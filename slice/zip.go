@@ -0,0 +1,54 @@
+package slice
+
+import (
+	"github.com/Warashi/go-generics/minmax"
+	"github.com/Warashi/go-generics/optional"
+	"github.com/Warashi/go-generics/types"
+)
+
+// ZipWith combines a and b element-wise with f. The result is as long as the shorter one.
+func ZipWith[A, B, C any](a []A, b []B, f types.BiFunction[A, B, C]) []C {
+	n := minmax.Min(len(a), len(b))
+	result := make([]C, n)
+	for i := 0; i < n; i++ {
+		result[i] = f.Apply(a[i], b[i])
+	}
+	return result
+}
+
+// Zip pairs a and b element-wise. The result is as long as the shorter one.
+func Zip[A, B any](a []A, b []B) []types.Pair[A, B] {
+	return ZipWith[A, B, types.Pair[A, B]](a, b, types.BiClosure[A, B, types.Pair[A, B]](types.NewPair[A, B]))
+}
+
+// ZipLongest pairs a and b element-wise. The result is as long as the longer one, padded with empty values.
+func ZipLongest[A, B any](a []A, b []B) []types.Pair[optional.Optional[A], optional.Optional[B]] {
+	n := minmax.Max(len(a), len(b))
+	result := make([]types.Pair[optional.Optional[A], optional.Optional[B]], n)
+	for i := 0; i < n; i++ {
+		if i < len(a) {
+			result[i].First = optional.New(a[i])
+		}
+		if i < len(b) {
+			result[i].Second = optional.New(b[i])
+		}
+	}
+	return result
+}
+
+func Unzip[A, B any](from []types.Pair[A, B]) ([]A, []B) {
+	a, b := make([]A, len(from)), make([]B, len(from))
+	for i, p := range from {
+		a[i], b[i] = p.Values()
+	}
+	return a, b
+}
+
+// Enumerate pairs each element of from with its index.
+func Enumerate[T any](from []T) []types.Pair[int, T] {
+	result := make([]types.Pair[int, T], len(from))
+	for i, v := range from {
+		result[i] = types.NewPair(i, v)
+	}
+	return result
+}
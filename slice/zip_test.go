@@ -0,0 +1,51 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Warashi/go-generics/optional"
+	"github.com/Warashi/go-generics/slice"
+	"github.com/Warashi/go-generics/types"
+)
+
+func TestZip(t *testing.T) {
+	got := slice.Zip([]int{1, 2, 3}, []string{"a", "b"})
+	if want := []types.Pair[int, string]{types.NewPair(1, "a"), types.NewPair(2, "b")}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	a, b := slice.Unzip(got)
+	if want := []int{1, 2}; !cmp.Equal(a, want) {
+		t.Errorf("Unzip() first = %v, want %v", a, want)
+	}
+	if want := []string{"a", "b"}; !cmp.Equal(b, want) {
+		t.Errorf("Unzip() second = %v, want %v", b, want)
+	}
+}
+
+func TestZipWith(t *testing.T) {
+	add := types.BiClosure[int, int, int](func(a, b int) int { return a + b })
+	if got, want := slice.ZipWith[int, int, int]([]int{1, 2}, []int{10, 20, 30}, add), []int{11, 22}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestZipLongest(t *testing.T) {
+	got := slice.ZipLongest([]int{1, 2}, []string{"a"})
+	want := []types.Pair[optional.Optional[int], optional.Optional[string]]{
+		types.NewPair(optional.New(1), optional.New("a")),
+		types.NewPair(optional.New(2), optional.Empty[string]()),
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestEnumerate(t *testing.T) {
+	got := slice.Enumerate([]string{"a", "b"})
+	if want := []types.Pair[int, string]{types.NewPair(0, "a"), types.NewPair(1, "b")}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
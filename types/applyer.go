@@ -4,6 +4,9 @@ var (
 	_ Function[int, bool]           = Closure[int, bool](nil)
 	_ BiFunction[int, string, bool] = BiClosure[int, string, bool](nil)
 	_ Consumer[int]                 = ConsumerClosure[int](nil)
+	_ BiConsumer[int, string]       = BiConsumerClosure[int, string](nil)
+	_ Supplier[int]                 = SupplierClosure[int](nil)
+	_ FallibleFunction[int, bool]   = FallibleClosure[int, bool](nil)
 
 	_ Function[int, int] = Identity[int]{}
 )
@@ -24,6 +27,18 @@ type Closure[F, T any] func(F) T
 
 func (f Closure[F, T]) Apply(value F) T { return f(value) }
 
+func NewFallibleFunction[F, T any](f func(F) (T, error)) FallibleFunction[F, T] {
+	return FallibleClosure[F, T](f)
+}
+
+type FallibleFunction[F, T any] interface {
+	Apply(F) (T, error)
+}
+
+type FallibleClosure[F, T any] func(F) (T, error)
+
+func (f FallibleClosure[F, T]) Apply(value F) (T, error) { return f(value) }
+
 func NewBiFunction[F1, F2, T any](f func(F1, F2) T) BiFunction[F1, F2, T] {
 	return BiClosure[F1, F2, T](f)
 }
@@ -47,3 +62,27 @@ type Consumer[T any] interface {
 type ConsumerClosure[T any] func(T)
 
 func (f ConsumerClosure[T]) Accept(value T) { f(value) }
+
+func NewBiConsumer[T, U any](f func(T, U)) BiConsumer[T, U] {
+	return BiConsumerClosure[T, U](f)
+}
+
+type BiConsumer[T, U any] interface {
+	Accept(T, U)
+}
+
+type BiConsumerClosure[T, U any] func(T, U)
+
+func (f BiConsumerClosure[T, U]) Accept(t T, u U) { f(t, u) }
+
+func NewSupplier[T any](f func() T) Supplier[T] {
+	return SupplierClosure[T](f)
+}
+
+type Supplier[T any] interface {
+	Get() T
+}
+
+type SupplierClosure[T any] func() T
+
+func (f SupplierClosure[T]) Get() T { return f() }
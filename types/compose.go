@@ -0,0 +1,41 @@
+package types
+
+// Compose returns the function x -> f(g(x)).
+func Compose[A, B, C any](f Function[B, C], g Function[A, B]) Function[A, C] {
+	return Closure[A, C](func(x A) C { return f.Apply(g.Apply(x)) })
+}
+
+// AndThen returns the function x -> g(f(x)), i.e. Compose with its arguments in pipeline order.
+func AndThen[A, B, C any](f Function[A, B], g Function[B, C]) Function[A, C] {
+	return Compose(g, f)
+}
+
+// Curry turns a BiFunction into a Function returning a Function.
+func Curry[A, B, C any](f BiFunction[A, B, C]) Function[A, Function[B, C]] {
+	return Closure[A, Function[B, C]](func(a A) Function[B, C] { return Partial(f, a) })
+}
+
+// Uncurry is the inverse of Curry.
+func Uncurry[A, B, C any](f Function[A, Function[B, C]]) BiFunction[A, B, C] {
+	return BiClosure[A, B, C](func(a A, b B) C { return f.Apply(a).Apply(b) })
+}
+
+// Partial fixes the first argument of f to a.
+func Partial[A, B, C any](f BiFunction[A, B, C], a A) Function[B, C] {
+	return Closure[B, C](func(b B) C { return f.Apply(a, b) })
+}
+
+// Flip swaps the arguments of f.
+func Flip[A, B, C any](f BiFunction[A, B, C]) BiFunction[B, A, C] {
+	return BiClosure[B, A, C](func(b B, a A) C { return f.Apply(a, b) })
+}
+
+// Const returns a Function that ignores its argument and always returns v.
+func Const[F, T any](v T) Function[F, T] {
+	return Closure[F, T](func(F) T { return v })
+}
+
+// Then returns a Consumer that passes the result of f to c.
+func Then[F, T any](f Function[F, T], c Consumer[T]) Consumer[F] {
+	return ConsumerClosure[F](func(v F) { c.Accept(f.Apply(v)) })
+}
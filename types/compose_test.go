@@ -0,0 +1,82 @@
+package types_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Warashi/go-generics/slice"
+	"github.com/Warashi/go-generics/types"
+)
+
+func TestCompose(t *testing.T) {
+	inc := types.Closure[int, int](func(v int) int { return v + 1 })
+	double := types.Closure[int, int](func(v int) int { return v * 2 })
+
+	if got, want := types.Compose[int, int, int](inc, double).Apply(3), 7; got != want {
+		t.Errorf("Compose() = %v, want %v", got, want)
+	}
+	if got, want := types.AndThen[int, int, int](inc, double).Apply(3), 8; got != want {
+		t.Errorf("AndThen() = %v, want %v", got, want)
+	}
+	itoa := types.AndThen[int, int, string](inc, types.Closure[int, string](strconv.Itoa))
+	if got, want := slice.Map([]int{1, 2}, itoa), []string{"2", "3"}; !cmp.Equal(got, want) {
+		t.Errorf("slice.Map() = %v, want %v", got, want)
+	}
+}
+
+func TestCurry(t *testing.T) {
+	sub := types.BiClosure[int, int, int](func(a, b int) int { return a - b })
+
+	if got, want := types.Curry[int, int, int](sub).Apply(5).Apply(3), 2; got != want {
+		t.Errorf("Curry() = %v, want %v", got, want)
+	}
+	if got, want := types.Uncurry(types.Curry[int, int, int](sub)).Apply(5, 3), 2; got != want {
+		t.Errorf("Uncurry() = %v, want %v", got, want)
+	}
+	if got, want := types.Partial[int, int, int](sub, 5).Apply(3), 2; got != want {
+		t.Errorf("Partial() = %v, want %v", got, want)
+	}
+	if got, want := types.Flip[int, int, int](sub).Apply(5, 3), -2; got != want {
+		t.Errorf("Flip() = %v, want %v", got, want)
+	}
+	if got, want := types.Const[int]("x").Apply(1), "x"; got != want {
+		t.Errorf("Const() = %v, want %v", got, want)
+	}
+}
+
+func TestPredicate(t *testing.T) {
+	positive := types.NewPredicate(func(v int) bool { return v > 0 })
+	even := types.NewPredicate(func(v int) bool { return v%2 == 0 })
+	in := []int{-2, -1, 0, 1, 2, 3, 4}
+
+	tests := []struct {
+		name string
+		p    types.Predicate[int]
+		want []int
+	}{
+		{name: "And", p: types.And[int](positive, even), want: []int{2, 4}},
+		{name: "Or", p: types.Or[int](positive, even), want: []int{-2, 0, 1, 2, 3, 4}},
+		{name: "Not", p: types.Not[int](positive), want: []int{-2, -1, 0}},
+		{name: "empty And", p: types.And[int](), want: in},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slice.Filter[int](in, tt.p); !cmp.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v, diff %v", got, tt.want, cmp.Diff(got, tt.want))
+			}
+		})
+	}
+}
+
+func TestSupplierConsumer(t *testing.T) {
+	var got []string
+	c := types.Then[int, string](types.Closure[int, string](strconv.Itoa), types.NewConsumer(func(s string) { got = append(got, s) }))
+	c.Accept(types.NewSupplier(func() int { return 1 }).Get())
+	types.NewBiConsumer(func(s string, n int) { got = append(got, s+strconv.Itoa(n)) }).Accept("x", 2)
+
+	if want := []string{"1", "x2"}; !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,47 @@
+package types
+
+var (
+	_ Predicate[int] = PredicateClosure[int](nil)
+	_ Predicate[int] = Closure[int, bool](nil)
+)
+
+// Predicate is a Function returning bool, so any Predicate can be passed to Filter functions and vice versa.
+type Predicate[T any] interface {
+	Function[T, bool]
+}
+
+func NewPredicate[T any](f func(T) bool) Predicate[T] {
+	return PredicateClosure[T](f)
+}
+
+type PredicateClosure[T any] func(T) bool
+
+func (f PredicateClosure[T]) Apply(value T) bool { return f(value) }
+
+// And returns a Predicate that is true when all of ps are true. It short-circuits from left to right.
+func And[T any](ps ...Function[T, bool]) Predicate[T] {
+	return PredicateClosure[T](func(value T) bool {
+		for _, p := range ps {
+			if !p.Apply(value) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// Or returns a Predicate that is true when any of ps is true. It short-circuits from left to right.
+func Or[T any](ps ...Function[T, bool]) Predicate[T] {
+	return PredicateClosure[T](func(value T) bool {
+		for _, p := range ps {
+			if p.Apply(value) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func Not[T any](p Function[T, bool]) Predicate[T] {
+	return PredicateClosure[T](func(value T) bool { return !p.Apply(value) })
+}
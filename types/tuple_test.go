@@ -0,0 +1,27 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/Warashi/go-generics/types"
+)
+
+func TestPair(t *testing.T) {
+	p := types.NewPair(1, "a")
+	if p.First != 1 || p.Second != "a" {
+		t.Errorf("NewPair() = %v, want {1 a}", p)
+	}
+	if a, b := p.Values(); a != 1 || b != "a" {
+		t.Errorf("Values() = %v, %v, want %v, %v", a, b, 1, "a")
+	}
+}
+
+func TestTriple(t *testing.T) {
+	tr := types.NewTriple(1, "a", true)
+	if tr.First != 1 || tr.Second != "a" || !tr.Third {
+		t.Errorf("NewTriple() = %v, want {1 a true}", tr)
+	}
+	if a, b, c := tr.Values(); a != 1 || b != "a" || !c {
+		t.Errorf("Values() = %v, %v, %v, want %v, %v, %v", a, b, c, 1, "a", true)
+	}
+}